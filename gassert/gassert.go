@@ -0,0 +1,165 @@
+// Package gassert
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+//
+// Package gassert provides assertions for tests.
+// On failure, the literal argument expressions of the assertion call are read from the test source
+// and printed along with their values, e.g.
+//
+//	gassert.Equal(t, 3, len(users))
+//
+// reports "Expected: len(users) is equal to: 3 but got: 2".
+// Every assertion reports the failure by t.Errorf and returns whether it passes,
+// use package gassert/require to stop the test on failure instead.
+package gassert
+
+import (
+	"time"
+
+	"github.com/hyphennn/glambda/internal/assert/engine"
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+// TestingT is the subset of *testing.T used by assertions.
+type TestingT = engine.TestingT
+
+// Equal asserts that expected and actual are equal, floats are compared with a tolerance of 1e-6.
+// Use EqualDelta for another tolerance.
+func Equal[T any](t TestingT, expected, actual T) bool {
+	t.Helper()
+	return engine.Equal(t, 1, expected, actual)
+}
+
+// EqualDelta asserts that expected and actual are equal, with floats, or slices of floats, compared with tolerance delta.
+func EqualDelta[T any](t TestingT, expected, actual T, delta float64) bool {
+	t.Helper()
+	return engine.EqualDelta(t, 1, expected, actual, delta)
+}
+
+// NotEqual asserts that expected and actual are not equal.
+func NotEqual[T any](t TestingT, expected, actual T) bool {
+	t.Helper()
+	return engine.NotEqual(t, 1, expected, actual)
+}
+
+// InDelta asserts that actual is within delta of expected.
+func InDelta[T constraints.Number](t TestingT, expected, actual T, delta float64) bool {
+	t.Helper()
+	return engine.InDelta(t, 1, expected, actual, delta)
+}
+
+// True asserts that value is true.
+func True[T ~bool](t TestingT, value T) bool {
+	t.Helper()
+	return engine.True(t, 1, value)
+}
+
+// False asserts that value is false.
+func False[T ~bool](t TestingT, value T) bool {
+	t.Helper()
+	return engine.False(t, 1, value)
+}
+
+// Panic asserts that f panics.
+func Panic(t TestingT, f func()) bool {
+	t.Helper()
+	return engine.Panic(t, 1, f)
+}
+
+// NotPanic asserts that f does not panic.
+func NotPanic(t TestingT, f func()) bool {
+	t.Helper()
+	return engine.NotPanic(t, 1, f)
+}
+
+// Nil asserts that value is nil, typed nil pointers, slices, maps, channels and functions are nil as well.
+func Nil(t TestingT, value any) bool {
+	t.Helper()
+	return engine.Nil(t, 1, value)
+}
+
+// NotNil asserts that value is not nil.
+func NotNil(t TestingT, value any) bool {
+	t.Helper()
+	return engine.NotNil(t, 1, value)
+}
+
+// Zero asserts that value is the zero value of its type.
+func Zero(t TestingT, value any) bool {
+	t.Helper()
+	return engine.Zero(t, 1, value)
+}
+
+// NotZero asserts that value is not the zero value of its type.
+func NotZero(t TestingT, value any) bool {
+	t.Helper()
+	return engine.NotZero(t, 1, value)
+}
+
+// Less asserts that actual is less than bound.
+func Less[T constraints.Ordered](t TestingT, bound, actual T) bool {
+	t.Helper()
+	return engine.Less(t, 1, bound, actual)
+}
+
+// Greater asserts that actual is greater than bound.
+func Greater[T constraints.Ordered](t TestingT, bound, actual T) bool {
+	t.Helper()
+	return engine.Greater(t, 1, bound, actual)
+}
+
+// Len asserts that obj, which is an array, channel, map, slice or string, has n elements.
+func Len(t TestingT, obj any, n int) bool {
+	t.Helper()
+	return engine.Len(t, 1, obj, n)
+}
+
+// Contains asserts that string container contains substring elem, or array or slice container contains element elem,
+// or map container contains key elem.
+func Contains(t TestingT, container, elem any) bool {
+	t.Helper()
+	return engine.Contains(t, 1, container, elem)
+}
+
+// NotContains is the opposite of Contains, it fails if container is not a string, array, slice or map.
+func NotContains(t TestingT, container, elem any) bool {
+	t.Helper()
+	return engine.NotContains(t, 1, container, elem)
+}
+
+// ElementsMatch asserts that expected and actual have the same elements regardless of order, counting duplicates.
+func ElementsMatch[T any](t TestingT, expected, actual []T) bool {
+	t.Helper()
+	return engine.ElementsMatch(t, 1, expected, actual)
+}
+
+// NoError asserts that err is nil.
+func NoError(t TestingT, err error) bool {
+	t.Helper()
+	return engine.NoError(t, 1, err)
+}
+
+// Error asserts that err is not nil.
+func Error(t TestingT, err error) bool {
+	t.Helper()
+	return engine.Error(t, 1, err)
+}
+
+// ErrorIs asserts that errors.Is(err, target) is true.
+func ErrorIs(t TestingT, err, target error) bool {
+	t.Helper()
+	return engine.ErrorIs(t, 1, err, target)
+}
+
+// ErrorAs asserts that errors.As(err, target) is true.
+func ErrorAs(t TestingT, err error, target any) bool {
+	t.Helper()
+	return engine.ErrorAs(t, 1, err, target)
+}
+
+// Eventually asserts that cond returns true within waitFor, cond is checked every tick.
+func Eventually(t TestingT, cond func() bool, waitFor, tick time.Duration) bool {
+	t.Helper()
+	return engine.Eventually(t, 1, cond, waitFor, tick)
+}
@@ -0,0 +1,161 @@
+// Package gassert
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gassert_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyphennn/glambda/gassert"
+	"github.com/hyphennn/glambda/gassert/require"
+)
+
+// mockT records the failures instead of failing the test.
+type mockT struct {
+	msgs   []string
+	failed bool
+}
+
+func (m *mockT) Helper() {}
+
+func (m *mockT) Errorf(format string, args ...any) {
+	m.msgs = append(m.msgs, fmt.Sprintf(format, args...))
+}
+
+func (m *mockT) FailNow() {
+	m.failed = true
+}
+
+func (m *mockT) msg() string {
+	return strings.Join(m.msgs, "\n")
+}
+
+func TestPickArgs(t *testing.T) {
+	m := &mockT{}
+	users := []string{"a", "b"}
+	gassert.False(t, gassert.Equal(m, 3, len(users)))
+	gassert.True(t, strings.Contains(m.msg(), "Expected: len(users)"))
+	gassert.True(t, strings.Contains(m.msg(), "is equal to: 3"))
+	gassert.True(t, strings.Contains(m.msg(), "but got: 2"))
+
+	// explicit type arguments are recognized as well
+	m = &mockT{}
+	gassert.Equal[int](m, 3, len(users))
+	gassert.True(t, strings.Contains(m.msg(), "Expected: len(users)"))
+
+	m = &mockT{}
+	ok := len(users) > 5
+	gassert.False(t, gassert.True(m, ok))
+	gassert.Equal(t, "Expect ok is true, but got false", m.msg())
+}
+
+func TestAssertions(t *testing.T) {
+	m := &mockT{}
+	gassert.True(t, gassert.NotEqual(m, 1, 2))
+	gassert.True(t, gassert.Equal(m, 0.1+0.2, 0.3))
+	gassert.True(t, gassert.InDelta(m, 10, 12, 2))
+	gassert.True(t, gassert.Panic(m, func() { panic(1) }))
+	gassert.True(t, gassert.NotPanic(m, func() {}))
+	gassert.True(t, gassert.Nil(m, (*int)(nil)))
+	gassert.True(t, gassert.Nil(m, []int(nil)))
+	gassert.True(t, gassert.NotNil(m, []int{}))
+	gassert.True(t, gassert.Zero(m, ""))
+	gassert.True(t, gassert.NotZero(m, 1))
+	gassert.True(t, gassert.Less(m, 2, 1))
+	gassert.True(t, gassert.Greater(m, "a", "b"))
+	gassert.True(t, gassert.Len(m, map[int]int{1: 1}, 1))
+	gassert.True(t, gassert.Len(m, "abc", 3))
+	gassert.True(t, gassert.Contains(m, "hello", "ell"))
+	gassert.True(t, gassert.Contains(m, []int{1, 2}, 2))
+	gassert.True(t, gassert.Contains(m, map[string]int{"a": 1}, "a"))
+	gassert.True(t, gassert.NotContains(m, []string{"a"}, "b"))
+	gassert.True(t, gassert.ElementsMatch(m, []int{1, 2, 2}, []int{2, 1, 2}))
+	gassert.True(t, gassert.NoError(m, nil))
+	gassert.True(t, gassert.Error(m, errors.New("e")))
+	gassert.Equal(t, "", m.msg())
+
+	m = &mockT{}
+	gassert.False(t, gassert.InDelta(m, 10, 13, 2))
+	gassert.False(t, gassert.Nil(m, 1))
+	gassert.False(t, gassert.Len(m, 1, 1))
+	gassert.False(t, gassert.Len(m, []int{1}, 2))
+	gassert.False(t, gassert.Contains(m, []int{1}, 2))
+	gassert.False(t, gassert.Contains(m, 1, 1))
+	gassert.False(t, gassert.NotContains(m, 1, 1))
+	gassert.False(t, gassert.ElementsMatch(m, []int{1, 2, 2}, []int{1, 2, 3}))
+	gassert.False(t, gassert.NoError(m, errors.New("boom")))
+	gassert.False(t, gassert.Error(m, nil))
+	gassert.Equal(t, 10, len(m.msgs))
+	gassert.False(t, m.failed)
+	gassert.True(t, strings.Contains(m.msgs[7], "missing: []int{2}"))
+	gassert.True(t, strings.Contains(m.msgs[7], "extra: []int{3}"))
+}
+
+func TestErrorAssertions(t *testing.T) {
+	_, err := os.Open("/no/such/file")
+	m := &mockT{}
+	gassert.True(t, gassert.ErrorIs(m, err, fs.ErrNotExist))
+	var pe *fs.PathError
+	gassert.True(t, gassert.ErrorAs(m, err, &pe))
+	gassert.Equal(t, "/no/such/file", pe.Path)
+
+	gassert.False(t, gassert.ErrorIs(m, err, fs.ErrExist))
+	gassert.True(t, strings.Contains(m.msg(), "Expect err is fs.ErrExist"))
+	var le *os.LinkError
+	gassert.False(t, gassert.ErrorAs(m, err, &le))
+}
+
+func TestEventually(t *testing.T) {
+	var n int32
+	m := &mockT{}
+	gassert.True(t, gassert.Eventually(m, func() bool {
+		return atomic.AddInt32(&n, 1) >= 3
+	}, time.Second, time.Millisecond))
+
+	gassert.False(t, gassert.Eventually(m, func() bool { return false }, 5*time.Millisecond, time.Millisecond))
+	gassert.True(t, strings.Contains(m.msg(), "within 5ms"))
+}
+
+func TestEqualDelta(t *testing.T) {
+	m := &mockT{}
+	gassert.True(t, gassert.Equal(m, 1.0, 1.0000001))
+	gassert.False(t, gassert.Equal(m, 1.0, 1.01))
+	gassert.True(t, gassert.EqualDelta(m, 1.0, 1.01, 0.1))
+	gassert.True(t, gassert.EqualDelta(m, []float32{1}, []float32{1.05}, 0.1))
+
+	// the tolerance is per call, Equal keeps the default one
+	m = &mockT{}
+	gassert.False(t, gassert.EqualDelta(m, []float64{1}, []float64{1.5}, 0.1))
+	gassert.True(t, strings.Contains(m.msg(), "Expected: []float64{1.5}"))
+	gassert.False(t, gassert.Equal(m, 1.0, 1.01))
+
+	m = &mockT{}
+	require.EqualDelta(m, 1.0, 1.01, 0.1)
+	gassert.False(t, m.failed)
+	require.EqualDelta(m, 1.0, 1.5, 0.1)
+	gassert.True(t, m.failed)
+}
+
+func TestRequire(t *testing.T) {
+	m := &mockT{}
+	require.Equal(m, 1, 1)
+	require.NoError(m, nil)
+	require.Len(m, []int{1}, 1)
+	gassert.False(t, m.failed)
+
+	require.Equal(m, 1, 2)
+	gassert.True(t, m.failed)
+	gassert.True(t, strings.Contains(m.msg(), "is equal to: 1"))
+
+	m = &mockT{}
+	require.ErrorIs(m, errors.New("a"), fs.ErrClosed)
+	gassert.True(t, m.failed)
+}
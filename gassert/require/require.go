@@ -0,0 +1,205 @@
+// Package require
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+//
+// Package require provides the same assertions as package gassert,
+// but stops the test by t.FailNow on failure.
+package require
+
+import (
+	"time"
+
+	"github.com/hyphennn/glambda/gassert"
+	"github.com/hyphennn/glambda/internal/assert/engine"
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+// TestingT is the subset of *testing.T used by assertions.
+type TestingT = gassert.TestingT
+
+// Equal requires that expected and actual are equal, floats are compared with a tolerance of 1e-6.
+// Use EqualDelta for another tolerance.
+func Equal[T any](t TestingT, expected, actual T) {
+	t.Helper()
+	if !engine.Equal(t, 1, expected, actual) {
+		t.FailNow()
+	}
+}
+
+// EqualDelta requires that expected and actual are equal, with floats, or slices of floats, compared with tolerance delta.
+func EqualDelta[T any](t TestingT, expected, actual T, delta float64) {
+	t.Helper()
+	if !engine.EqualDelta(t, 1, expected, actual, delta) {
+		t.FailNow()
+	}
+}
+
+// NotEqual requires that expected and actual are not equal.
+func NotEqual[T any](t TestingT, expected, actual T) {
+	t.Helper()
+	if !engine.NotEqual(t, 1, expected, actual) {
+		t.FailNow()
+	}
+}
+
+// InDelta requires that actual is within delta of expected.
+func InDelta[T constraints.Number](t TestingT, expected, actual T, delta float64) {
+	t.Helper()
+	if !engine.InDelta(t, 1, expected, actual, delta) {
+		t.FailNow()
+	}
+}
+
+// True requires that value is true.
+func True[T ~bool](t TestingT, value T) {
+	t.Helper()
+	if !engine.True(t, 1, value) {
+		t.FailNow()
+	}
+}
+
+// False requires that value is false.
+func False[T ~bool](t TestingT, value T) {
+	t.Helper()
+	if !engine.False(t, 1, value) {
+		t.FailNow()
+	}
+}
+
+// Panic requires that f panics.
+func Panic(t TestingT, f func()) {
+	t.Helper()
+	if !engine.Panic(t, 1, f) {
+		t.FailNow()
+	}
+}
+
+// NotPanic requires that f does not panic.
+func NotPanic(t TestingT, f func()) {
+	t.Helper()
+	if !engine.NotPanic(t, 1, f) {
+		t.FailNow()
+	}
+}
+
+// Nil requires that value is nil, typed nil pointers, slices, maps, channels and functions are nil as well.
+func Nil(t TestingT, value any) {
+	t.Helper()
+	if !engine.Nil(t, 1, value) {
+		t.FailNow()
+	}
+}
+
+// NotNil requires that value is not nil.
+func NotNil(t TestingT, value any) {
+	t.Helper()
+	if !engine.NotNil(t, 1, value) {
+		t.FailNow()
+	}
+}
+
+// Zero requires that value is the zero value of its type.
+func Zero(t TestingT, value any) {
+	t.Helper()
+	if !engine.Zero(t, 1, value) {
+		t.FailNow()
+	}
+}
+
+// NotZero requires that value is not the zero value of its type.
+func NotZero(t TestingT, value any) {
+	t.Helper()
+	if !engine.NotZero(t, 1, value) {
+		t.FailNow()
+	}
+}
+
+// Less requires that actual is less than bound.
+func Less[T constraints.Ordered](t TestingT, bound, actual T) {
+	t.Helper()
+	if !engine.Less(t, 1, bound, actual) {
+		t.FailNow()
+	}
+}
+
+// Greater requires that actual is greater than bound.
+func Greater[T constraints.Ordered](t TestingT, bound, actual T) {
+	t.Helper()
+	if !engine.Greater(t, 1, bound, actual) {
+		t.FailNow()
+	}
+}
+
+// Len requires that obj, which is an array, channel, map, slice or string, has n elements.
+func Len(t TestingT, obj any, n int) {
+	t.Helper()
+	if !engine.Len(t, 1, obj, n) {
+		t.FailNow()
+	}
+}
+
+// Contains requires that string container contains substring elem, or array or slice container contains element elem,
+// or map container contains key elem.
+func Contains(t TestingT, container, elem any) {
+	t.Helper()
+	if !engine.Contains(t, 1, container, elem) {
+		t.FailNow()
+	}
+}
+
+// NotContains is the opposite of Contains, it fails if container is not a string, array, slice or map.
+func NotContains(t TestingT, container, elem any) {
+	t.Helper()
+	if !engine.NotContains(t, 1, container, elem) {
+		t.FailNow()
+	}
+}
+
+// ElementsMatch requires that expected and actual have the same elements regardless of order, counting duplicates.
+func ElementsMatch[T any](t TestingT, expected, actual []T) {
+	t.Helper()
+	if !engine.ElementsMatch(t, 1, expected, actual) {
+		t.FailNow()
+	}
+}
+
+// NoError requires that err is nil.
+func NoError(t TestingT, err error) {
+	t.Helper()
+	if !engine.NoError(t, 1, err) {
+		t.FailNow()
+	}
+}
+
+// Error requires that err is not nil.
+func Error(t TestingT, err error) {
+	t.Helper()
+	if !engine.Error(t, 1, err) {
+		t.FailNow()
+	}
+}
+
+// ErrorIs requires that errors.Is(err, target) is true.
+func ErrorIs(t TestingT, err, target error) {
+	t.Helper()
+	if !engine.ErrorIs(t, 1, err, target) {
+		t.FailNow()
+	}
+}
+
+// ErrorAs requires that errors.As(err, target) is true.
+func ErrorAs(t TestingT, err error, target any) {
+	t.Helper()
+	if !engine.ErrorAs(t, 1, err, target) {
+		t.FailNow()
+	}
+}
+
+// Eventually requires that cond returns true within waitFor, cond is checked every tick.
+func Eventually(t TestingT, cond func() bool, waitFor, tick time.Duration) {
+	t.Helper()
+	if !engine.Eventually(t, 1, cond, waitFor, tick) {
+		t.FailNow()
+	}
+}
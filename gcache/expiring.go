@@ -0,0 +1,197 @@
+// Package gcache
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gcache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// ExpiringCache is a cache whose entries expire after a TTL.
+// When it is full, the least recently used entry is evicted.
+// Expired entries are removed lazily when accessed, or by [ExpiringCache.Cleanup].
+type ExpiringCache[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+	// ll holds *expiringEntry, the front is the most recently used one.
+	ll      *list.List
+	items   map[K]*list.Element
+	onEvict func(K, V)
+	stats   Stats
+}
+
+type expiringEntry[K comparable, V any] struct {
+	key   K
+	value V
+	// expireAt is zero if the entry never expires.
+	expireAt time.Time
+}
+
+var _ Cache[int, int] = (*ExpiringCache[int, int])(nil)
+
+// NewExpiringCache returns a cache holding at most capacity entries, each of them expires ttl after it is set.
+// A non-positive capacity means unbounded, and a non-positive ttl means entries never expire by default.
+// The optional onEvict is called with each entry evicted because it expired or the cache is full.
+//
+// EXAMPLE:
+//
+//	c := NewExpiringCache[string, int](100, time.Minute)
+//	c.Set("a", 1)
+//	c.SetWithTTL("b", 2, time.Second)
+func NewExpiringCache[K comparable, V any](capacity int, ttl time.Duration, onEvict ...func(K, V)) *ExpiringCache[K, V] {
+	return &ExpiringCache[K, V]{
+		capacity: capacity,
+		ttl:      ttl,
+		now:      time.Now,
+		ll:       list.New(),
+		items:    make(map[K]*list.Element),
+		onEvict:  optional(onEvict),
+	}
+}
+
+// WithClock replaces the clock of the cache, which is time.Now by default, and returns the cache.
+// It is intended for deterministic tests.
+//
+// EXAMPLE:
+//
+//	now := time.Unix(0, 0)
+//	c := NewExpiringCache[string, int](0, time.Second).WithClock(func() time.Time { return now })
+func (c *ExpiringCache[K, V]) WithClock(now func() time.Time) *ExpiringCache[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+	return c
+}
+
+func (c *ExpiringCache[K, V]) expired(ent *expiringEntry[K, V], now time.Time) bool {
+	return !ent.expireAt.IsZero() && !now.Before(ent.expireAt)
+}
+
+// evict removes element e and records it as evicted.
+func (c *ExpiringCache[K, V]) evict(e *list.Element, es []evicted[K, V]) []evicted[K, V] {
+	ent := e.Value.(*expiringEntry[K, V])
+	c.ll.Remove(e)
+	delete(c.items, ent.key)
+	c.stats.Evictions++
+	return append(es, evicted[K, V]{ent.key, ent.value})
+}
+
+func (c *ExpiringCache[K, V]) Get(k K) (V, bool) {
+	var zero V
+	c.mu.Lock()
+	e, ok := c.items[k]
+	if !ok {
+		c.stats.Misses++
+		c.mu.Unlock()
+		return zero, false
+	}
+	ent := e.Value.(*expiringEntry[K, V])
+	if c.expired(ent, c.now()) {
+		es := c.evict(e, nil)
+		c.stats.Misses++
+		c.mu.Unlock()
+		notify(c.onEvict, es)
+		return zero, false
+	}
+	c.stats.Hits++
+	c.ll.MoveToFront(e)
+	// read the value under the lock, SetWithTTL updates the entry in place
+	v := ent.value
+	c.mu.Unlock()
+	return v, true
+}
+
+// Set stores the value for key k with the default TTL of the cache.
+func (c *ExpiringCache[K, V]) Set(k K, v V) {
+	c.SetWithTTL(k, v, c.ttl)
+}
+
+// SetWithTTL stores the value for key k which expires after ttl, a non-positive ttl means never.
+func (c *ExpiringCache[K, V]) SetWithTTL(k K, v V, ttl time.Duration) {
+	c.mu.Lock()
+	var expireAt time.Time
+	if ttl > 0 {
+		expireAt = c.now().Add(ttl)
+	}
+	if e, ok := c.items[k]; ok {
+		ent := e.Value.(*expiringEntry[K, V])
+		ent.value, ent.expireAt = v, expireAt
+		c.ll.MoveToFront(e)
+		c.mu.Unlock()
+		return
+	}
+	c.items[k] = c.ll.PushFront(&expiringEntry[K, V]{key: k, value: v, expireAt: expireAt})
+	var es []evicted[K, V]
+	for c.capacity > 0 && c.ll.Len() > c.capacity {
+		es = c.evict(c.ll.Back(), es)
+	}
+	c.mu.Unlock()
+	notify(c.onEvict, es)
+}
+
+func (c *ExpiringCache[K, V]) Delete(k K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[k]
+	if !ok {
+		return false
+	}
+	c.ll.Remove(e)
+	delete(c.items, k)
+	return true
+}
+
+// Cleanup removes all expired entries and returns the number of them.
+func (c *ExpiringCache[K, V]) Cleanup() int {
+	c.mu.Lock()
+	now := c.now()
+	var es []evicted[K, V]
+	for e := c.ll.Front(); e != nil; {
+		next := e.Next()
+		if c.expired(e.Value.(*expiringEntry[K, V]), now) {
+			es = c.evict(e, es)
+		}
+		e = next
+	}
+	c.mu.Unlock()
+	notify(c.onEvict, es)
+	return len(es)
+}
+
+// Len returns the number of entries which are not expired yet.
+func (c *ExpiringCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	n := 0
+	for e := c.ll.Front(); e != nil; e = e.Next() {
+		if !c.expired(e.Value.(*expiringEntry[K, V]), now) {
+			n++
+		}
+	}
+	return n
+}
+
+func (c *ExpiringCache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ll.Init()
+	c.items = make(map[K]*list.Element)
+}
+
+func (c *ExpiringCache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// GetOrLoad is the method form of [GetOrLoad], the loaded value is stored with the default TTL.
+func (c *ExpiringCache[K, V]) GetOrLoad(ctx context.Context, k K, fc func(context.Context, K) (V, error)) (V, error) {
+	return GetOrLoad[K, V](ctx, c, k, fc)
+}
@@ -0,0 +1,87 @@
+// Package gcache
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gcache
+
+import (
+	"context"
+)
+
+// Cache is the common interface of [LRU], [LFU] and [ExpiringCache].
+// All implementations are safe for concurrent use.
+type Cache[K comparable, V any] interface {
+	// Get returns the value for key k and whether it is present, it counts as a hit or a miss.
+	Get(k K) (V, bool)
+	// Set stores the value for key k, it may evict other entries.
+	Set(k K, v V)
+	// Delete removes key k and returns whether it was present.
+	Delete(k K) bool
+	// Len returns the number of entries.
+	Len() int
+	// Purge removes all entries, the statistics are kept.
+	Purge()
+	// Stats returns the statistics of the cache.
+	Stats() Stats
+}
+
+// Stats is the hit/miss statistics of a cache.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRate returns Hits / (Hits + Misses), or 0 if there is no access.
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// GetOrLoad returns the value for key k from cache c.
+// On a miss, it calls fc with ctx and k, stores the result in c and returns it.
+// The error of fc is returned as is, and nothing is stored.
+// fc has the same shape as the one of gutils.MustDoCtx.
+//
+// Concurrent misses of the same key may call fc more than once.
+//
+// EXAMPLE:
+//
+//	GetOrLoad(ctx, c, userID, userService.GetUser) => (*User, error)
+func GetOrLoad[K comparable, V any](ctx context.Context, c Cache[K, V], k K, fc func(context.Context, K) (V, error)) (V, error) {
+	if v, ok := c.Get(k); ok {
+		return v, nil
+	}
+	v, err := fc(ctx, k)
+	if err != nil {
+		return v, err
+	}
+	c.Set(k, v)
+	return v, nil
+}
+
+type evicted[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// notify calls onEvict for each evicted entry, it must be called without holding the lock.
+func notify[K comparable, V any](onEvict func(K, V), es []evicted[K, V]) {
+	if onEvict == nil {
+		return
+	}
+	for _, e := range es {
+		onEvict(e.key, e.value)
+	}
+}
+
+func optional[T any](ts []T) T {
+	var t T
+	if len(ts) != 0 {
+		t = ts[0]
+	}
+	return t
+}
@@ -0,0 +1,185 @@
+// Package gcache
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gcache_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyphennn/glambda/gcache"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestLRU(t *testing.T) {
+	var evicted []string
+	c := gcache.NewLRU(2, func(k string, v int) { evicted = append(evicted, k) })
+	c.Set("a", 1)
+	c.Set("b", 2)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	c.Set("c", 3)
+
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, []string{"c", "a"}, c.Keys())
+	assert.Equal(t, 2, c.Len())
+
+	// 更新已有 key 不触发淘汰
+	c.Set("a", 10)
+	v, _ = c.Peek("a")
+	assert.Equal(t, 10, v)
+	assert.Equal(t, []string{"b"}, evicted)
+
+	assert.True(t, c.Delete("a"))
+	assert.False(t, c.Delete("a"))
+	assert.Equal(t, gcache.Stats{Hits: 1, Misses: 1, Evictions: 1}, c.Stats())
+	assert.Equal(t, 0.5, c.Stats().HitRate())
+
+	c.Purge()
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestLFU(t *testing.T) {
+	var evicted []string
+	c := gcache.NewLFU(2, func(k string, v int) { evicted = append(evicted, k) })
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Set("c", 3)
+	assert.Equal(t, []string{"b"}, evicted)
+	_, ok := c.Peek("b")
+	assert.False(t, ok)
+
+	// 频率相同时淘汰最久未使用的
+	c.Get("c")
+	c.Set("d", 4)
+	assert.Equal(t, []string{"b", "c"}, evicted)
+
+	// 删除最低频的 key 后仍能正确淘汰
+	assert.True(t, c.Delete("d"))
+	c.Set("e", 5)
+	c.Set("f", 6)
+	assert.Equal(t, []string{"b", "c", "e"}, evicted)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 2, c.Len())
+
+	c.Purge()
+	assert.Equal(t, 0, c.Len())
+	c.Set("x", 1)
+	v, ok = c.Get("x")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestExpiringCache(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var evicted []string
+	c := gcache.NewExpiringCache(2, time.Second, func(k string, v int) { evicted = append(evicted, k) }).
+		WithClock(clock.Now)
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, 3*time.Second)
+
+	clock.now = clock.now.Add(time.Second)
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	v, ok := c.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, []string{"a"}, evicted)
+
+	// 容量满时淘汰最久未使用的
+	c.SetWithTTL("c", 3, 0)
+	c.Set("d", 4)
+	assert.Equal(t, []string{"a", "b"}, evicted)
+
+	clock.now = clock.now.Add(time.Hour)
+	assert.Equal(t, 1, c.Len())
+	assert.Equal(t, 1, c.Cleanup())
+	assert.Equal(t, []string{"a", "b", "d"}, evicted)
+	v, ok = c.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	assert.Equal(t, gcache.Stats{Hits: 2, Misses: 1, Evictions: 3}, c.Stats())
+	assert.True(t, c.Delete("c"))
+	c.Set("e", 5)
+	c.Purge()
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestGetOrLoad(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	load := func(_ context.Context, k string) (int, error) {
+		calls++
+		return strconv.Atoi(k)
+	}
+	for _, c := range []interface {
+		gcache.Cache[string, int]
+		GetOrLoad(context.Context, string, func(context.Context, string) (int, error)) (int, error)
+	}{
+		gcache.NewLRU[string, int](10),
+		gcache.NewLFU[string, int](10),
+		gcache.NewExpiringCache[string, int](10, time.Minute),
+	} {
+		calls = 0
+		v, err := c.GetOrLoad(ctx, "1", load)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, v)
+		v, err = gcache.GetOrLoad[string, int](ctx, c, "1", load)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, v)
+		assert.Equal(t, 1, calls)
+
+		_, err = c.GetOrLoad(ctx, "a", load)
+		var numErr *strconv.NumError
+		assert.True(t, errors.As(err, &numErr))
+		_, ok := c.Get("a")
+		assert.False(t, ok)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	for _, c := range []gcache.Cache[int, int]{
+		gcache.NewLRU[int, int](50),
+		gcache.NewLFU[int, int](50),
+		gcache.NewExpiringCache[int, int](50, time.Minute),
+	} {
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 1000; i++ {
+					c.Set((g*i)%100, i)
+					c.Get(i % 100)
+					if i%10 == 0 {
+						c.Delete(i % 100)
+					}
+				}
+			}(g)
+		}
+		wg.Wait()
+		assert.True(t, c.Len() <= 50)
+	}
+}
@@ -0,0 +1,170 @@
+// Package gcache
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gcache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// LFU is a cache which evicts the least frequently used entry when it is full,
+// ties are broken by evicting the least recently used one.
+// Get and Set are O(1).
+type LFU[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[K]*list.Element
+	// freqs holds a list of *lfuEntry for each access frequency, the front is the most recently used one.
+	freqs   map[int]*list.List
+	minFreq int
+	onEvict func(K, V)
+	stats   Stats
+}
+
+type lfuEntry[K comparable, V any] struct {
+	key   K
+	value V
+	freq  int
+}
+
+var _ Cache[int, int] = (*LFU[int, int])(nil)
+
+// NewLFU returns an LFU cache holding at most capacity entries, a non-positive capacity means unbounded.
+// The optional onEvict is called with each entry evicted because the cache is full.
+//
+// EXAMPLE:
+//
+//	c := NewLFU[string, int](2)
+//	c.Set("a", 1); c.Set("b", 2); c.Get("a"); c.Get("b"); c.Get("a"); c.Set("c", 3)
+//	c.Get("b") => (0, false)
+func NewLFU[K comparable, V any](capacity int, onEvict ...func(K, V)) *LFU[K, V] {
+	return &LFU[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*list.Element),
+		freqs:    make(map[int]*list.List),
+		onEvict:  optional(onEvict),
+	}
+}
+
+func (c *LFU[K, V]) push(ent *lfuEntry[K, V]) *list.Element {
+	l, ok := c.freqs[ent.freq]
+	if !ok {
+		l = list.New()
+		c.freqs[ent.freq] = l
+	}
+	return l.PushFront(ent)
+}
+
+func (c *LFU[K, V]) remove(e *list.Element) *lfuEntry[K, V] {
+	ent := e.Value.(*lfuEntry[K, V])
+	l := c.freqs[ent.freq]
+	l.Remove(e)
+	if l.Len() == 0 {
+		delete(c.freqs, ent.freq)
+		if c.minFreq == ent.freq {
+			c.minFreq++
+		}
+	}
+	return ent
+}
+
+func (c *LFU[K, V]) touch(e *list.Element) *lfuEntry[K, V] {
+	ent := c.remove(e)
+	ent.freq++
+	c.items[ent.key] = c.push(ent)
+	return ent
+}
+
+func (c *LFU[K, V]) Get(k K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[k]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	return c.touch(e).value, true
+}
+
+// Peek returns the value for key k without updating the frequency or the statistics.
+func (c *LFU[K, V]) Peek(k K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.Value.(*lfuEntry[K, V]).value, true
+}
+
+func (c *LFU[K, V]) Set(k K, v V) {
+	c.mu.Lock()
+	if e, ok := c.items[k]; ok {
+		c.touch(e).value = v
+		c.mu.Unlock()
+		return
+	}
+	var es []evicted[K, V]
+	if c.capacity > 0 && len(c.items) >= c.capacity {
+		ent := c.remove(c.freqs[c.minFreq].Back())
+		delete(c.items, ent.key)
+		c.stats.Evictions++
+		es = append(es, evicted[K, V]{ent.key, ent.value})
+	}
+	c.items[k] = c.push(&lfuEntry[K, V]{key: k, value: v, freq: 1})
+	c.minFreq = 1
+	c.mu.Unlock()
+	notify(c.onEvict, es)
+}
+
+func (c *LFU[K, V]) Delete(k K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[k]
+	if !ok {
+		return false
+	}
+	c.remove(e)
+	delete(c.items, k)
+	if len(c.items) != 0 && c.freqs[c.minFreq] == nil {
+		// the removed entry was the last one with minFreq, find the new one
+		c.minFreq = 0
+		for f := range c.freqs {
+			if c.minFreq == 0 || f < c.minFreq {
+				c.minFreq = f
+			}
+		}
+	}
+	return true
+}
+
+func (c *LFU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+func (c *LFU[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items = make(map[K]*list.Element)
+	c.freqs = make(map[int]*list.List)
+	c.minFreq = 0
+}
+
+func (c *LFU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// GetOrLoad is the method form of [GetOrLoad].
+func (c *LFU[K, V]) GetOrLoad(ctx context.Context, k K, fc func(context.Context, K) (V, error)) (V, error) {
+	return GetOrLoad[K, V](ctx, c, k, fc)
+}
@@ -0,0 +1,141 @@
+// Package gcache
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gcache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// LRU is a cache which evicts the least recently used entry when it is full.
+type LRU[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	// ll holds *lruEntry, the front is the most recently used one.
+	ll      *list.List
+	items   map[K]*list.Element
+	onEvict func(K, V)
+	stats   Stats
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+var _ Cache[int, int] = (*LRU[int, int])(nil)
+
+// NewLRU returns an LRU cache holding at most capacity entries, a non-positive capacity means unbounded.
+// The optional onEvict is called with each entry evicted because the cache is full.
+//
+// EXAMPLE:
+//
+//	c := NewLRU[string, int](2)
+//	c.Set("a", 1); c.Set("b", 2); c.Get("a"); c.Set("c", 3)
+//	c.Get("b") => (0, false)
+func NewLRU[K comparable, V any](capacity int, onEvict ...func(K, V)) *LRU[K, V] {
+	return &LRU[K, V]{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[K]*list.Element),
+		onEvict:  optional(onEvict),
+	}
+}
+
+func (c *LRU[K, V]) Get(k K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[k]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.ll.MoveToFront(e)
+	return e.Value.(*lruEntry[K, V]).value, true
+}
+
+// Peek returns the value for key k without updating the recency or the statistics.
+func (c *LRU[K, V]) Peek(k K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.Value.(*lruEntry[K, V]).value, true
+}
+
+func (c *LRU[K, V]) Set(k K, v V) {
+	c.mu.Lock()
+	if e, ok := c.items[k]; ok {
+		e.Value.(*lruEntry[K, V]).value = v
+		c.ll.MoveToFront(e)
+		c.mu.Unlock()
+		return
+	}
+	c.items[k] = c.ll.PushFront(&lruEntry[K, V]{key: k, value: v})
+	var es []evicted[K, V]
+	for c.capacity > 0 && c.ll.Len() > c.capacity {
+		back := c.ll.Back()
+		ent := back.Value.(*lruEntry[K, V])
+		c.ll.Remove(back)
+		delete(c.items, ent.key)
+		c.stats.Evictions++
+		es = append(es, evicted[K, V]{ent.key, ent.value})
+	}
+	c.mu.Unlock()
+	notify(c.onEvict, es)
+}
+
+func (c *LRU[K, V]) Delete(k K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[k]
+	if !ok {
+		return false
+	}
+	c.ll.Remove(e)
+	delete(c.items, k)
+	return true
+}
+
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// Keys returns the keys from the most recently used to the least recently used.
+func (c *LRU[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ret := make([]K, 0, c.ll.Len())
+	for e := c.ll.Front(); e != nil; e = e.Next() {
+		ret = append(ret, e.Value.(*lruEntry[K, V]).key)
+	}
+	return ret
+}
+
+func (c *LRU[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ll.Init()
+	c.items = make(map[K]*list.Element)
+}
+
+func (c *LRU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// GetOrLoad is the method form of [GetOrLoad].
+func (c *LRU[K, V]) GetOrLoad(ctx context.Context, k K, fc func(context.Context, K) (V, error)) (V, error) {
+	return GetOrLoad[K, V](ctx, c, k, fc)
+}
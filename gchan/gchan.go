@@ -0,0 +1,272 @@
+// Package gchan
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+//
+// All functions of gchan start goroutines which stop and close their output channels
+// when the input channels are closed or ctx is done. Values not yet delivered when ctx
+// is done are dropped.
+package gchan
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// send sends v to out, it returns false if ctx is done first.
+func send[T any](ctx context.Context, out chan<- T, v T) bool {
+	select {
+	case out <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// OrDone returns a channel which receives the values of in until in is closed or ctx is done.
+// It turns a plain range loop over in into a cancellable one.
+//
+// EXAMPLE:
+//
+//	for v := range OrDone(ctx, in) { ... } // stops when ctx is done
+func OrDone[T any](ctx context.Context, in <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok || !send(ctx, out, v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FanIn merges the values of all ins into one channel, which is closed when all ins are closed.
+// The order between different inputs is not specified.
+//
+// EXAMPLE:
+//
+//	FanIn(ctx, ch1, ch2) => receives values of both ch1 and ch2
+//
+// HINT:
+//
+//   - Use [FanOut] to do the opposite.
+func FanIn[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func(in <-chan T) {
+			defer wg.Done()
+			for v := range OrDone(ctx, in) {
+				if !send(ctx, out, v) {
+					return
+				}
+			}
+		}(in)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut distributes the values of in over n channels, each value is delivered to exactly one of them.
+// A value goes to whichever output is ready first, so a slow consumer does not block the others.
+// It panics if n is not positive.
+//
+// EXAMPLE:
+//
+//	for _, ch := range FanOut(ctx, jobs, 4) {
+//		go worker(ch)
+//	}
+//
+// HINT:
+//
+//   - Use [Broadcast] if each value should be delivered to all outputs.
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n <= 0 {
+		panic("gchan: FanOut n must be positive")
+	}
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			for v := range OrDone(ctx, in) {
+				if !send(ctx, out, v) {
+					return
+				}
+			}
+		}()
+	}
+	return outs
+}
+
+// Broadcast delivers each value of in to all of n channels, each of them has a buffer of size buf.
+// Values are delivered in order, so a subscriber whose buffer is full blocks all of them.
+// It panics if n is not positive.
+//
+// EXAMPLE:
+//
+//	subs := Broadcast(ctx, events, 3, 16) => 3 channels receiving every event
+//
+// HINT:
+//
+//   - Use [Tee] for the common case of 2 unbuffered subscribers.
+func Broadcast[T any](ctx context.Context, in <-chan T, n, buf int) []<-chan T {
+	if n <= 0 {
+		panic("gchan: Broadcast n must be positive")
+	}
+	outs := make([]chan T, n)
+	ret := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T, buf)
+		ret[i] = outs[i]
+	}
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+		for v := range OrDone(ctx, in) {
+			for _, out := range outs {
+				if !send(ctx, out, v) {
+					return
+				}
+			}
+		}
+	}()
+	return ret
+}
+
+// Tee delivers each value of in to both returned channels.
+//
+// EXAMPLE:
+//
+//	a, b := Tee(ctx, in) => both a and b receive all values of in
+func Tee[T any](ctx context.Context, in <-chan T) (<-chan T, <-chan T) {
+	outs := Broadcast(ctx, in, 2, 0)
+	return outs[0], outs[1]
+}
+
+// MapChan applies function fc to each value of in, results are sent to the returned channel in order.
+//
+// EXAMPLE:
+//
+//	MapChan(ctx, ints, strconv.Itoa) => a channel of strings
+//
+// HINT:
+//
+//   - It is the channel version of gslice.Map.
+func MapChan[F, T any](ctx context.Context, in <-chan F, fc func(F) T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for v := range OrDone(ctx, in) {
+			if !send(ctx, out, fc(v)) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FilterChan sends the values of in for which fc returns true to the returned channel.
+//
+// EXAMPLE:
+//
+//	FilterChan(ctx, ints, func(i int) bool { return i%2 == 0 }) => a channel of even numbers
+//
+// HINT:
+//
+//   - It is the channel version of gslice.Filter.
+func FilterChan[T any](ctx context.Context, in <-chan T, fc func(T) bool) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for v := range OrDone(ctx, in) {
+			if fc(v) && !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Batch groups the values of in into slices of at most size values.
+// A batch is emitted when it is full, or maxWait after its first value arrived, whichever comes first.
+// The last partial batch is emitted when in is closed. A non-positive maxWait means no timeout.
+// It panics if size is not positive.
+//
+// EXAMPLE:
+//
+//	for ids := range Batch(ctx, idCh, 100, 50*time.Millisecond) {
+//		batchGet(ids)
+//	}
+func Batch[T any](ctx context.Context, in <-chan T, size int, maxWait time.Duration) <-chan []T {
+	if size <= 0 {
+		panic("gchan: Batch size must be positive")
+	}
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		var (
+			buf   []T
+			timer *time.Timer
+			// timeout is nil while there is no pending batch, receiving from it blocks forever.
+			timeout <-chan time.Time
+		)
+		stop := func() {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+		}
+		defer stop()
+		flush := func() bool {
+			stop()
+			if len(buf) == 0 {
+				return true
+			}
+			b := buf
+			buf = nil
+			return send(ctx, out, b)
+		}
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				buf = append(buf, v)
+				if len(buf) == 1 && maxWait > 0 {
+					timer = time.NewTimer(maxWait)
+					timeout = timer.C
+				}
+				if len(buf) >= size && !flush() {
+					return
+				}
+			case <-timeout:
+				timer, timeout = nil, nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
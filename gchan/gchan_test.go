@@ -0,0 +1,134 @@
+// Package gchan
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gchan_test
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyphennn/glambda/gchan"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func gen(vs ...int) <-chan int {
+	ch := make(chan int, len(vs))
+	for _, v := range vs {
+		ch <- v
+	}
+	close(ch)
+	return ch
+}
+
+func collect[T any](ch <-chan T) []T {
+	ret := make([]T, 0)
+	for v := range ch {
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+func TestOrDone(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, []int{1, 2, 3}, collect(gchan.OrDone(ctx, gen(1, 2, 3))))
+
+	canceled, cancel := context.WithCancel(ctx)
+	block := make(chan int)
+	out := gchan.OrDone(canceled, block)
+	cancel()
+	assert.Equal(t, []int{}, collect(out))
+}
+
+func TestFanIn(t *testing.T) {
+	ret := collect(gchan.FanIn(context.Background(), gen(1, 2), gen(3), gen()))
+	sort.Ints(ret)
+	assert.Equal(t, []int{1, 2, 3}, ret)
+	assert.Equal(t, []int{}, collect(gchan.FanIn[int](context.Background())))
+}
+
+func TestFanOut(t *testing.T) {
+	outs := gchan.FanOut(context.Background(), gen(1, 2, 3, 4, 5, 6), 3)
+	assert.Equal(t, 3, len(outs))
+	var (
+		mu  sync.Mutex
+		ret []int
+		wg  sync.WaitGroup
+	)
+	for _, out := range outs {
+		wg.Add(1)
+		go func(out <-chan int) {
+			defer wg.Done()
+			for v := range out {
+				mu.Lock()
+				ret = append(ret, v)
+				mu.Unlock()
+			}
+		}(out)
+	}
+	wg.Wait()
+	sort.Ints(ret)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, ret)
+	assert.Panic(t, func() { gchan.FanOut(context.Background(), gen(), 0) })
+}
+
+func TestBroadcast(t *testing.T) {
+	outs := gchan.Broadcast(context.Background(), gen(1, 2, 3), 3, 3)
+	for _, out := range outs {
+		assert.Equal(t, []int{1, 2, 3}, collect(out))
+	}
+
+	a, b := gchan.Tee(context.Background(), gen(1, 2))
+	var ra, rb []int
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() { defer wg.Done(); ra = collect(a) }()
+	go func() { defer wg.Done(); rb = collect(b) }()
+	wg.Wait()
+	assert.Equal(t, []int{1, 2}, ra)
+	assert.Equal(t, []int{1, 2}, rb)
+}
+
+func TestMapFilterChan(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, []string{"1", "2"}, collect(gchan.MapChan(ctx, gen(1, 2), strconv.Itoa)))
+	assert.Equal(t,
+		[]int{2, 4},
+		collect(gchan.FilterChan(ctx, gen(1, 2, 3, 4), func(i int) bool { return i%2 == 0 })),
+	)
+}
+
+func TestBatch(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, collect(gchan.Batch(ctx, gen(1, 2, 3, 4, 5), 2, 0)))
+	assert.Equal(t, [][]int{}, collect(gchan.Batch(ctx, gen(), 2, time.Second)))
+
+	// 超时后即使未满也会输出
+	in := make(chan int)
+	out := gchan.Batch(ctx, in, 10, 10*time.Millisecond)
+	in <- 1
+	in <- 2
+	assert.Equal(t, []int{1, 2}, <-out)
+	in <- 3
+	close(in)
+	assert.Equal(t, []int{3}, <-out)
+	_, ok := <-out
+	assert.False(t, ok)
+
+	assert.Panic(t, func() { gchan.Batch(ctx, in, 0, 0) })
+}
+
+func TestCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	out := gchan.MapChan(ctx, gchan.FilterChan(ctx, in, func(int) bool { return true }), func(i int) int { return i })
+	in <- 1
+	assert.Equal(t, 1, <-out)
+	cancel()
+	_, ok := <-out
+	assert.False(t, ok)
+}
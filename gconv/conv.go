@@ -20,12 +20,96 @@ func FromPtr[T any](t *T) T {
 }
 
 func StringPtr(s string) *string {
-	return &s
+	return ptr(s)
 }
 
 func Ptr2String(s *string) string {
-	if s == nil {
-		return ""
+	return fromPtr(s)
+}
+
+// FromPtrOr returns *p, or def if p is nil.
+//
+// EXAMPLE:
+//
+//	FromPtrOr(req.Limit, 20) => *req.Limit if it is set, otherwise 20
+func FromPtrOr[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// PtrIf returns a pointer to v if cond is true, otherwise nil.
+// It is handy to fill optional fields.
+//
+// EXAMPLE:
+//
+//	req.Name = PtrIf(name != "", name)
+func PtrIf[T any](cond bool, v T) *T {
+	if !cond {
+		return nil
+	}
+	return &v
+}
+
+// DerefDeep returns **pp, or the zero value of T if either level is nil.
+func DerefDeep[T any](pp **T) T {
+	if pp == nil {
+		return gvalue.Zero[T]()
+	}
+	return FromPtr(*pp)
+}
+
+// ToPtrSlice returns pointers to copies of the elements of s, modifying them does not change s.
+//
+// EXAMPLE:
+//
+//	ToPtrSlice([]int{1, 2}) => []*int{&1, &2}
+func ToPtrSlice[T any](s []T) []*T {
+	vs := append(make([]T, 0, len(s)), s...)
+	ret := make([]*T, 0, len(s))
+	for i := range vs {
+		ret = append(ret, &vs[i])
+	}
+	return ret
+}
+
+// FromPtrSlice dereferences each element of s, nil elements become zero values.
+//
+// EXAMPLE:
+//
+//	FromPtrSlice([]*int{ToPtr(1), nil}) => []int{1, 0}
+func FromPtrSlice[T any](s []*T) []T {
+	ret := make([]T, 0, len(s))
+	for _, p := range s {
+		ret = append(ret, FromPtr(p))
+	}
+	return ret
+}
+
+// PtrEqual returns true if a and b are both nil, or point to equal values.
+//
+// EXAMPLE:
+//
+//	PtrEqual(ToPtr(1), ToPtr(1)) => true
+//	PtrEqual(ToPtr(1), nil)      => false
+func PtrEqual[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// Coalesce returns the first non-nil pointer of ps, or nil if all of them are nil.
+//
+// EXAMPLE:
+//
+//	FromPtr(Coalesce(req.Name, user.Nickname, StringPtr("anonymous")))
+func Coalesce[T any](ps ...*T) *T {
+	for _, p := range ps {
+		if p != nil {
+			return p
+		}
 	}
-	return *s
+	return nil
 }
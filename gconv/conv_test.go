@@ -20,3 +20,52 @@ func TestFromPtr(t *testing.T) {
 	s := "123"
 	assert.Equal(t, "123", gconv.FromPtr(&s))
 }
+
+func TestFromPtrOr(t *testing.T) {
+	assert.Equal(t, 20, gconv.FromPtrOr(nil, 20))
+	assert.Equal(t, 5, gconv.FromPtrOr(gconv.ToPtr(5), 20))
+
+	assert.True(t, gconv.PtrIf(false, "a") == nil)
+	assert.Equal(t, "a", *gconv.PtrIf(true, "a"))
+}
+
+func TestDerefDeep(t *testing.T) {
+	p := gconv.ToPtr(1)
+	assert.Equal(t, 1, gconv.DerefDeep(&p))
+	var np *int
+	assert.Equal(t, 0, gconv.DerefDeep(&np))
+	assert.Equal(t, 0, gconv.DerefDeep[int](nil))
+}
+
+func TestPtrSlice(t *testing.T) {
+	s := []int{1, 2}
+	ps := gconv.ToPtrSlice(s)
+	*ps[0] = 100
+	assert.Equal(t, []int{1, 2}, s)
+	assert.Equal(t, []int{100, 2}, gconv.FromPtrSlice(ps))
+	assert.Equal(t, []int{1, 0}, gconv.FromPtrSlice([]*int{gconv.ToPtr(1), nil}))
+	assert.Equal(t, 0, len(gconv.ToPtrSlice[int](nil)))
+}
+
+func TestPtrEqualCoalesce(t *testing.T) {
+	assert.True(t, gconv.PtrEqual(gconv.ToPtr(1), gconv.ToPtr(1)))
+	assert.False(t, gconv.PtrEqual(gconv.ToPtr(1), gconv.ToPtr(2)))
+	assert.False(t, gconv.PtrEqual(gconv.ToPtr(1), nil))
+	assert.True(t, gconv.PtrEqual[int](nil, nil))
+
+	a, b := gconv.StringPtr("a"), gconv.StringPtr("b")
+	assert.True(t, gconv.Coalesce(nil, a, b) == a)
+	assert.True(t, gconv.Coalesce[string](nil, nil) == nil)
+	assert.True(t, gconv.Coalesce[string]() == nil)
+}
+
+func TestTypedPtr(t *testing.T) {
+	assert.Equal(t, int32(1), *gconv.Int32Ptr(1))
+	assert.Equal(t, uint8(2), *gconv.Uint8Ptr(2))
+	assert.Equal(t, 1.5, *gconv.Float64Ptr(1.5))
+	assert.Equal(t, complex64(1+2i), *gconv.Complex64Ptr(1 + 2i))
+	assert.Equal(t, int64(3), gconv.Ptr2Int64(gconv.Int64Ptr(3)))
+	assert.Equal(t, uintptr(0), gconv.Ptr2Uintptr(nil))
+	assert.Equal(t, "", gconv.Ptr2String(nil))
+	assert.Equal(t, "s", gconv.Ptr2String(gconv.StringPtr("s")))
+}
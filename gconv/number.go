@@ -0,0 +1,203 @@
+// Package gconv
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gconv
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"unsafe"
+
+	"github.com/hyphennn/glambda/gslice"
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+var (
+	// ErrOverflow means the value is out of the range of the target type.
+	ErrOverflow = errors.New("value out of range")
+	// ErrSignLoss means a negative value is converted to an unsigned type.
+	ErrSignLoss = errors.New("negative value to unsigned type")
+	// ErrTruncated means a float with a fractional part is converted to an integer type.
+	ErrTruncated = errors.New("fractional part truncated")
+	// ErrNaN means NaN is converted to an integer type.
+	ErrNaN = errors.New("NaN to integer type")
+	// ErrPrecisionLoss means an integer cannot be represented exactly by the target float type.
+	ErrPrecisionLoss = errors.New("integer not representable by float type")
+)
+
+func isFloat[T constraints.Number]() bool {
+	half := 0.5
+	return T(half) != 0
+}
+
+func isSigned[T constraints.Number]() bool {
+	var z T
+	return z-1 < 0
+}
+
+func bitSize[T constraints.Number]() int {
+	var z T
+	return int(unsafe.Sizeof(z)) * 8
+}
+
+func convertErr[To, From constraints.Number](v From, err error) error {
+	var to To
+	return fmt.Errorf("gconv: cannot convert %v (%T) to %T: %w", v, v, to, err)
+}
+
+// Convert converts v from type From to type To, it returns an error wrapping one of
+// ErrOverflow, ErrSignLoss, ErrTruncated, ErrNaN and ErrPrecisionLoss if the value is changed by the conversion.
+// Converting between float types only fails when a finite value overflows, rounding is not an error.
+//
+// EXAMPLE:
+//
+//	Convert[int8](int64(100))   => (100, nil)
+//	Convert[int8](int64(200))   => (-56, ErrOverflow)
+//	Convert[uint](-1)           => (math.MaxUint, ErrSignLoss)
+//	Convert[int](1.5)           => (1, ErrTruncated)
+//	Convert[float64](1<<53 + 1) => (1<<53, ErrPrecisionLoss)
+func Convert[To, From constraints.Number](v From) (To, error) {
+	r := To(v)
+	if err := checkConvert[To](v); err != nil {
+		return r, convertErr[To](v, err)
+	}
+	return r, nil
+}
+
+func checkConvert[To, From constraints.Number](v From) error {
+	switch {
+	case isFloat[From]() && isFloat[To]():
+		return checkFloatToFloat(float64(v), float64(To(v)))
+	case isFloat[From]():
+		return checkFloatToInt(float64(v), bitSize[To](), isSigned[To]())
+	case isFloat[To]():
+		// the magnitude of v, the negation of math.MinInt64 wraps to itself which is still right as uint64
+		u := uint64(v)
+		if v < 0 {
+			u = uint64(-int64(v))
+		}
+		return checkIntToFloat(u, bitSize[To]())
+	default:
+		r := To(v)
+		if v < 0 && !isSigned[To]() {
+			return ErrSignLoss
+		}
+		if From(r) != v || (r < 0) != (v < 0) {
+			return ErrOverflow
+		}
+		return nil
+	}
+}
+
+// checkFloatToFloat checks the conversion of float f to r.
+func checkFloatToFloat(f, r float64) error {
+	if !math.IsInf(f, 0) && math.IsInf(r, 0) {
+		return ErrOverflow
+	}
+	return nil
+}
+
+// checkFloatToInt checks the conversion of float f to an integer type of the given size and signedness.
+func checkFloatToInt(f float64, size int, signed bool) error {
+	if math.IsNaN(f) {
+		return ErrNaN
+	}
+	if f != math.Trunc(f) && f > -1 && f < 0 && !signed {
+		// e.g. -0.5 to uint is 0 with the fractional part truncated
+		return ErrTruncated
+	}
+	// the bounds are powers of two so they are exact, min is inclusive and max is exclusive
+	min, max := 0.0, math.Ldexp(1, size)
+	if signed {
+		min, max = -math.Ldexp(1, size-1), math.Ldexp(1, size-1)
+	}
+	switch {
+	case f < 0 && !signed:
+		return ErrSignLoss
+	case f < min || f >= max:
+		return ErrOverflow
+	case f != math.Trunc(f):
+		return ErrTruncated
+	}
+	return nil
+}
+
+// checkIntToFloat checks the conversion of an integer of magnitude u to a float type of the given size.
+func checkIntToFloat(u uint64, size int) error {
+	mantissa := 53
+	if size == 32 {
+		mantissa = 24
+	}
+	if u != 0 && bits.Len64(u>>bits.TrailingZeros64(u)) > mantissa {
+		return ErrPrecisionLoss
+	}
+	return nil
+}
+
+// MustConvert is the variant of [Convert] which panics on error.
+func MustConvert[To, From constraints.Number](v From) To {
+	r, err := Convert[To](v)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ClampConvert converts v from type From to type To, values out of the range of To are clamped to its bounds.
+// Fractional parts are truncated toward zero, NaN is converted to 0, and infinities of float types are kept.
+//
+// EXAMPLE:
+//
+//	ClampConvert[int8](1000)     => 127
+//	ClampConvert[uint](-1)       => 0
+//	ClampConvert[int](-1.5)      => -1
+//	ClampConvert[int](math.NaN()) => 0
+func ClampConvert[To, From constraints.Number](v From) To {
+	switch checkConvert[To](v) {
+	case ErrNaN:
+		return 0
+	case ErrSignLoss, ErrOverflow:
+		return bound[To](v < 0)
+	default:
+		return To(v)
+	}
+}
+
+// bound returns the minimum or maximum value of type T, the finite ones for float types.
+func bound[T constraints.Number](min bool) T {
+	n := bitSize[T]()
+	switch {
+	case isFloat[T]():
+		f := math.MaxFloat64
+		if n == 32 {
+			f = math.MaxFloat32
+		}
+		if min {
+			f = -f
+		}
+		return T(f)
+	case isSigned[T]():
+		if min {
+			return T(int64(-1) << (n - 1))
+		}
+		return T(uint64(1)<<(n-1) - 1)
+	default:
+		if min {
+			return 0
+		}
+		return T(^uint64(0) >> (64 - n))
+	}
+}
+
+// ConvertSlice converts each element of s by [Convert].
+// It stops at the first error, and returns the elements converted so far with the error.
+//
+// EXAMPLE:
+//
+//	ConvertSlice[int32]([]int64{1, 2}) => ([]int32{1, 2}, nil)
+func ConvertSlice[To, From constraints.Number](s []From) ([]To, error) {
+	return gslice.TryMap(s, Convert[To, From])
+}
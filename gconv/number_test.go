@@ -0,0 +1,126 @@
+// Package gconv
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gconv_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hyphennn/glambda/gconv"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestConvertInteger(t *testing.T) {
+	i8, err := gconv.Convert[int8](int64(100))
+	assert.Nil(t, err)
+	assert.Equal(t, int8(100), i8)
+
+	i8, err = gconv.Convert[int8](int64(200))
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+	assert.Equal(t, int8(-56), i8)
+	_, err = gconv.Convert[int8](-129)
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+	_, err = gconv.Convert[int8](-128)
+	assert.Nil(t, err)
+
+	_, err = gconv.Convert[uint](-1)
+	assert.True(t, errors.Is(err, gconv.ErrSignLoss))
+	_, err = gconv.Convert[uint8](int8(-1))
+	assert.True(t, errors.Is(err, gconv.ErrSignLoss))
+	_, err = gconv.Convert[int8](uint8(200))
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+	_, err = gconv.Convert[int64](uint64(math.MaxUint64))
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+	u64, err := gconv.Convert[uint64](int64(math.MaxInt64))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(math.MaxInt64), u64)
+
+	type myInt int16
+	_, err = gconv.Convert[myInt](int32(math.MaxInt16 + 1))
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+	assert.Equal(t, "gconv: cannot convert 32768 (int32) to gconv_test.myInt: value out of range", err.Error())
+}
+
+func TestConvertFloat(t *testing.T) {
+	i, err := gconv.Convert[int](1.5)
+	assert.True(t, errors.Is(err, gconv.ErrTruncated))
+	assert.Equal(t, 1, i)
+	i, err = gconv.Convert[int](-3.0)
+	assert.Nil(t, err)
+	assert.Equal(t, -3, i)
+
+	_, err = gconv.Convert[int](math.NaN())
+	assert.True(t, errors.Is(err, gconv.ErrNaN))
+	_, err = gconv.Convert[int64](math.Inf(1))
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+	_, err = gconv.Convert[int64](math.Ldexp(1, 63))
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+	_, err = gconv.Convert[int64](-math.Ldexp(1, 63))
+	assert.Nil(t, err)
+	_, err = gconv.Convert[uint8](255.0)
+	assert.Nil(t, err)
+	_, err = gconv.Convert[uint8](float32(256))
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+	_, err = gconv.Convert[uint8](-1.0)
+	assert.True(t, errors.Is(err, gconv.ErrSignLoss))
+	_, err = gconv.Convert[uint8](-0.5)
+	assert.True(t, errors.Is(err, gconv.ErrTruncated))
+
+	_, err = gconv.Convert[float32](1e300)
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+	f32, err := gconv.Convert[float32](0.1)
+	assert.Nil(t, err)
+	assert.Equal(t, float32(0.1), f32)
+	_, err = gconv.Convert[float32](math.Inf(-1))
+	assert.Nil(t, err)
+	_, err = gconv.Convert[float64](float32(math.NaN()))
+	assert.Nil(t, err)
+
+	_, err = gconv.Convert[float64](1<<53 + 1)
+	assert.True(t, errors.Is(err, gconv.ErrPrecisionLoss))
+	_, err = gconv.Convert[float64](int64(math.MinInt64))
+	assert.Nil(t, err)
+	_, err = gconv.Convert[float64](uint64(math.MaxUint64))
+	assert.True(t, errors.Is(err, gconv.ErrPrecisionLoss))
+	_, err = gconv.Convert[float32](1<<24 + 1)
+	assert.True(t, errors.Is(err, gconv.ErrPrecisionLoss))
+	_, err = gconv.Convert[float32](-(1 << 24))
+	assert.Nil(t, err)
+}
+
+func TestMustConvert(t *testing.T) {
+	assert.Equal(t, int16(3), gconv.MustConvert[int16](3.0))
+	defer func() {
+		assert.True(t, errors.Is(recover().(error), gconv.ErrOverflow))
+	}()
+	gconv.MustConvert[int8](1000)
+}
+
+func TestClampConvert(t *testing.T) {
+	assert.Equal(t, int8(127), gconv.ClampConvert[int8](1000))
+	assert.Equal(t, int8(-128), gconv.ClampConvert[int8](-1000.5))
+	assert.Equal(t, uint(0), gconv.ClampConvert[uint](-1))
+	assert.Equal(t, uint64(math.MaxUint64), gconv.ClampConvert[uint64](math.Inf(1)))
+	assert.Equal(t, int64(math.MaxInt64), gconv.ClampConvert[int64](uint64(math.MaxUint64)))
+	assert.Equal(t, int32(math.MinInt32), gconv.ClampConvert[int32](int64(math.MinInt64)))
+	assert.Equal(t, -1, gconv.ClampConvert[int](-1.5))
+	assert.Equal(t, uint8(0), gconv.ClampConvert[uint8](-0.5))
+	assert.Equal(t, 0, gconv.ClampConvert[int](math.NaN()))
+	assert.Equal(t, float32(math.MaxFloat32), gconv.ClampConvert[float32](1e300))
+	assert.Equal(t, float32(-math.MaxFloat32), gconv.ClampConvert[float32](-1e300))
+	assert.True(t, math.IsInf(float64(gconv.ClampConvert[float32](math.Inf(1))), 1))
+	assert.Equal(t, float64(1<<53), gconv.ClampConvert[float64](1<<53+1))
+}
+
+func TestConvertSlice(t *testing.T) {
+	s, err := gconv.ConvertSlice[int32]([]int64{1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{1, 2}, s)
+
+	s, err = gconv.ConvertSlice[int32]([]int64{1, math.MaxInt64, 3})
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+	assert.Equal(t, []int32{1}, s)
+}
@@ -0,0 +1,39 @@
+// Package gconv
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gconv
+
+import (
+	"time"
+)
+
+type config struct {
+	timeLayout string
+	tag        string
+}
+
+// Option configures the parsing, formatting and struct conversion functions of gconv.
+type Option func(*config)
+
+// WithTimeLayout sets the layout used to parse and format time.Time, the default is time.RFC3339Nano.
+func WithTimeLayout(layout string) Option {
+	return func(c *config) {
+		c.timeLayout = layout
+	}
+}
+
+// WithTag sets the struct tag used by StructToMap and MapToStruct, the default is "json".
+func WithTag(tag string) Option {
+	return func(c *config) {
+		c.tag = tag
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{timeLayout: time.RFC3339Nano, tag: "json"}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
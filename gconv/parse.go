@@ -0,0 +1,162 @@
+// Package gconv
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gconv
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedType is returned when parsing a string into a type which Parse does not know.
+var ErrUnsupportedType = errors.New("unsupported type")
+
+// Parse parses s into a value of type T.
+// T can be a string, bool, integer or float type (including named ones such as `type Level int`),
+// time.Duration, time.Time, or a type whose pointer implements encoding.TextUnmarshaler.
+// Integers are parsed in base 10, and time.Time is parsed by the layout given by WithTimeLayout.
+//
+// EXAMPLE:
+//
+//	Parse[int]("42")              => (42, nil)
+//	Parse[int8]("200")            => (127, error wrapping *strconv.NumError)
+//	Parse[time.Duration]("1m30s") => (90*time.Second, nil)
+//	Parse[net.IP]("127.0.0.1")    => (net.IP{127, 0, 0, 1}, nil)
+//	Parse[time.Time]("2026-10-17", WithTimeLayout("2006-01-02"))
+func Parse[T any](s string, opts ...Option) (T, error) {
+	var t T
+	if err := parse(s, &t, newConfig(opts)); err != nil {
+		return t, fmt.Errorf("gconv: cannot parse %q as %T: %w", s, t, err)
+	}
+	return t, nil
+}
+
+func parse(s string, p any, c *config) error {
+	switch p := p.(type) {
+	case *time.Time:
+		t, err := time.Parse(c.timeLayout, s)
+		if err != nil {
+			return err
+		}
+		*p = t
+		return nil
+	case *time.Duration:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		*p = d
+		return nil
+	case encoding.TextUnmarshaler:
+		return p.UnmarshalText([]byte(s))
+	}
+
+	v := reflect.ValueOf(p).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		v.SetInt(i)
+		return err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		v.SetUint(u)
+		return err
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		v.SetFloat(f)
+		return err
+	default:
+		return ErrUnsupportedType
+	}
+	return nil
+}
+
+// ParseOr is the variant of [Parse] which returns def if s cannot be parsed.
+//
+// EXAMPLE:
+//
+//	ParseOr(r.URL.Query().Get("limit"), 20)
+func ParseOr[T any](s string, def T, opts ...Option) T {
+	t, err := Parse[T](s, opts...)
+	if err != nil {
+		return def
+	}
+	return t
+}
+
+// ParseSlice splits s by sep and parses each element by [Parse], spaces around elements are trimmed.
+// An empty s is parsed to an empty slice.
+//
+// EXAMPLE:
+//
+//	ParseSlice[int]("1, 2,3", ",") => ([]int{1, 2, 3}, nil)
+//	ParseSlice[int]("", ",")       => ([]int{}, nil)
+//	ParseSlice[int]("1,a", ",")    => ([]int{1}, error)
+func ParseSlice[T any](s, sep string, opts ...Option) ([]T, error) {
+	if s == "" {
+		return []T{}, nil
+	}
+	ss := strings.Split(s, sep)
+	ret := make([]T, 0, len(ss))
+	for _, e := range ss {
+		t, err := Parse[T](strings.TrimSpace(e), opts...)
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, t)
+	}
+	return ret, nil
+}
+
+// Format formats v as a string which can be parsed back by [Parse].
+// Floats are formatted in the shortest representation, time.Time is formatted by the layout given by
+// WithTimeLayout, and values of other types fall back to fmt.Sprint.
+//
+// EXAMPLE:
+//
+//	Format(42)               => "42"
+//	Format(0.1)              => "0.1"
+//	Format(90 * time.Second) => "1m30s"
+func Format[T any](v T, opts ...Option) string {
+	switch v := any(v).(type) {
+	case time.Time:
+		return v.Format(newConfig(opts).timeLayout)
+	case time.Duration:
+		return v.String()
+	case encoding.TextMarshaler:
+		if bs, err := v.MarshalText(); err == nil {
+			return string(bs)
+		}
+		return fmt.Sprint(v)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits())
+	default:
+		return fmt.Sprint(v)
+	}
+}
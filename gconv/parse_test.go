@@ -0,0 +1,127 @@
+// Package gconv
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gconv_test
+
+import (
+	"errors"
+	"math"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hyphennn/glambda/gconv"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+type level int
+
+func TestParse(t *testing.T) {
+	i, err := gconv.Parse[int]("42")
+	assert.Nil(t, err)
+	assert.Equal(t, 42, i)
+
+	i8, err := gconv.Parse[int8]("200")
+	var ne *strconv.NumError
+	assert.True(t, errors.As(err, &ne))
+	assert.True(t, errors.Is(err, strconv.ErrRange))
+	assert.Equal(t, int8(127), i8)
+
+	_, err = gconv.Parse[uint]("-1")
+	assert.NotNil(t, err)
+	u16, err := gconv.Parse[uint16]("65535")
+	assert.Nil(t, err)
+	assert.Equal(t, uint16(65535), u16)
+
+	f, err := gconv.Parse[float32]("0.1")
+	assert.Nil(t, err)
+	assert.Equal(t, float32(0.1), f)
+
+	b, err := gconv.Parse[bool]("true")
+	assert.Nil(t, err)
+	assert.True(t, b)
+
+	l, err := gconv.Parse[level]("3")
+	assert.Nil(t, err)
+	assert.Equal(t, level(3), l)
+
+	s, err := gconv.Parse[string]("abc")
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", s)
+
+	_, err = gconv.Parse[int]("abc")
+	assert.Equal(t, `gconv: cannot parse "abc" as int: strconv.ParseInt: parsing "abc": invalid syntax`, err.Error())
+	_, err = gconv.Parse[[]int]("1")
+	assert.True(t, errors.Is(err, gconv.ErrUnsupportedType))
+}
+
+func TestParseTime(t *testing.T) {
+	d, err := gconv.Parse[time.Duration]("1m30s")
+	assert.Nil(t, err)
+	assert.Equal(t, 90*time.Second, d)
+
+	tm, err := gconv.Parse[time.Time]("2026-10-17T08:00:00Z")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1792224000), tm.Unix())
+
+	tm, err = gconv.Parse[time.Time]("2026-10-17", gconv.WithTimeLayout("2006-01-02"))
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), tm)
+	_, err = gconv.Parse[time.Time]("2026-10-17")
+	assert.NotNil(t, err)
+
+	ip, err := gconv.Parse[net.IP]("127.0.0.1")
+	assert.Nil(t, err)
+	assert.True(t, ip.Equal(net.IPv4(127, 0, 0, 1)))
+	_, err = gconv.Parse[net.IP]("x")
+	assert.NotNil(t, err)
+}
+
+func TestParseOrSlice(t *testing.T) {
+	assert.Equal(t, 20, gconv.ParseOr("", 20))
+	assert.Equal(t, 5, gconv.ParseOr("5", 20))
+	assert.Equal(t, time.Second, gconv.ParseOr("x", time.Second))
+
+	is, err := gconv.ParseSlice[int]("1, 2,3", ",")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, is)
+
+	is, err = gconv.ParseSlice[int]("", ",")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{}, is)
+
+	is, err = gconv.ParseSlice[int]("1|a|3", "|")
+	assert.NotNil(t, err)
+	assert.Equal(t, []int{1}, is)
+
+	ds, err := gconv.ParseSlice[time.Duration]("1s;2m", ";")
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Minute}, ds)
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "42", gconv.Format(42))
+	assert.Equal(t, "-7", gconv.Format(int8(-7)))
+	assert.Equal(t, "18446744073709551615", gconv.Format(uint64(math.MaxUint64)))
+	assert.Equal(t, "0.1", gconv.Format(0.1))
+	assert.Equal(t, "0.1", gconv.Format(float32(0.1)))
+	assert.Equal(t, "true", gconv.Format(true))
+	assert.Equal(t, "3", gconv.Format(level(3)))
+	assert.Equal(t, "abc", gconv.Format("abc"))
+	assert.Equal(t, "1m30s", gconv.Format(90*time.Second))
+	assert.Equal(t, "127.0.0.1", gconv.Format(net.IPv4(127, 0, 0, 1)))
+	assert.Equal(t, "[1 2]", gconv.Format([]int{1, 2}))
+
+	tm := time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC)
+	assert.Equal(t, "2026-10-17T08:00:00Z", gconv.Format(tm))
+	assert.Equal(t, "2026-10-17", gconv.Format(tm, gconv.WithTimeLayout("2006-01-02")))
+
+	// values round-trip through Format and Parse
+	for _, f := range []float64{0.1, 1e21, -3.5, math.MaxFloat64} {
+		g, err := gconv.Parse[float64](gconv.Format(f))
+		assert.Nil(t, err)
+		assert.Equal(t, f, g)
+	}
+}
@@ -0,0 +1,145 @@
+// Package gconv
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gconv
+
+import (
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+// ptr and fromPtr are ToPtr and FromPtr limited to the types which have typed shortcuts below.
+func ptr[T constraints.UnPtrAble](v T) *T {
+	return &v
+}
+
+func fromPtr[T constraints.UnPtrAble](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+// The functions below are the typed shortcuts of ToPtr and FromPtr like StringPtr and Ptr2String,
+// they are handy where type inference does not help, e.g. Int32Ptr(1) instead of ToPtr[int32](1).
+
+func IntPtr(v int) *int {
+	return ptr(v)
+}
+
+func Ptr2Int(p *int) int {
+	return fromPtr(p)
+}
+
+func Int8Ptr(v int8) *int8 {
+	return ptr(v)
+}
+
+func Ptr2Int8(p *int8) int8 {
+	return fromPtr(p)
+}
+
+func Int16Ptr(v int16) *int16 {
+	return ptr(v)
+}
+
+func Ptr2Int16(p *int16) int16 {
+	return fromPtr(p)
+}
+
+func Int32Ptr(v int32) *int32 {
+	return ptr(v)
+}
+
+func Ptr2Int32(p *int32) int32 {
+	return fromPtr(p)
+}
+
+func Int64Ptr(v int64) *int64 {
+	return ptr(v)
+}
+
+func Ptr2Int64(p *int64) int64 {
+	return fromPtr(p)
+}
+
+func UintPtr(v uint) *uint {
+	return ptr(v)
+}
+
+func Ptr2Uint(p *uint) uint {
+	return fromPtr(p)
+}
+
+func Uint8Ptr(v uint8) *uint8 {
+	return ptr(v)
+}
+
+func Ptr2Uint8(p *uint8) uint8 {
+	return fromPtr(p)
+}
+
+func Uint16Ptr(v uint16) *uint16 {
+	return ptr(v)
+}
+
+func Ptr2Uint16(p *uint16) uint16 {
+	return fromPtr(p)
+}
+
+func Uint32Ptr(v uint32) *uint32 {
+	return ptr(v)
+}
+
+func Ptr2Uint32(p *uint32) uint32 {
+	return fromPtr(p)
+}
+
+func Uint64Ptr(v uint64) *uint64 {
+	return ptr(v)
+}
+
+func Ptr2Uint64(p *uint64) uint64 {
+	return fromPtr(p)
+}
+
+func UintptrPtr(v uintptr) *uintptr {
+	return ptr(v)
+}
+
+func Ptr2Uintptr(p *uintptr) uintptr {
+	return fromPtr(p)
+}
+
+func Float32Ptr(v float32) *float32 {
+	return ptr(v)
+}
+
+func Ptr2Float32(p *float32) float32 {
+	return fromPtr(p)
+}
+
+func Float64Ptr(v float64) *float64 {
+	return ptr(v)
+}
+
+func Ptr2Float64(p *float64) float64 {
+	return fromPtr(p)
+}
+
+func Complex64Ptr(v complex64) *complex64 {
+	return ptr(v)
+}
+
+func Ptr2Complex64(p *complex64) complex64 {
+	return fromPtr(p)
+}
+
+func Complex128Ptr(v complex128) *complex128 {
+	return ptr(v)
+}
+
+func Ptr2Complex128(p *complex128) complex128 {
+	return fromPtr(p)
+}
@@ -0,0 +1,431 @@
+// Package gconv
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gconv
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotStruct is returned when the argument of StructToMap or MapToStruct is not a struct as required.
+	ErrNotStruct = errors.New("gconv: not a struct")
+	// ErrTypeMismatch means a value in the map cannot be assigned to the struct field.
+	ErrTypeMismatch = errors.New("type mismatch")
+)
+
+// FieldError is the error of a struct field in MapToStruct.
+type FieldError struct {
+	// Field is the path of the field, e.g. "address.city".
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("gconv: field %s: %v", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// structField is a field of a struct, fields of embedded structs are flattened.
+type structField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+	// tagged is true if the name comes from the struct tag.
+	tagged bool
+}
+
+// structFields returns the exported fields of struct type t named by tag, in the order of their indexes.
+// It follows the rules of encoding/json:
+//
+//   - fields of embedded structs are promoted, and each embedded struct type is visited once,
+//     so that a type embedding a pointer to itself terminates;
+//   - a name is taken by its shallowest fields; if there are several of them, the only tagged one wins,
+//     otherwise the name is dropped.
+func structFields(t reflect.Type, tag string) []structField {
+	type embedded struct {
+		typ   reflect.Type
+		index []int
+	}
+	var (
+		ret     []structField
+		next    = []embedded{{typ: t}}
+		visited = map[reflect.Type]bool{}
+		// used holds the names taken or dropped at shallower depths.
+		used = map[string]bool{}
+	)
+	for len(next) > 0 {
+		current := next
+		next = nil
+		// count is the number of times each type is embedded at the current depth.
+		count := map[reflect.Type]int{}
+		for _, e := range current {
+			count[e.typ]++
+		}
+		var (
+			level  []structField
+			names  []string
+			byName = map[string][]structField{}
+		)
+		for _, e := range current {
+			if visited[e.typ] {
+				continue
+			}
+			visited[e.typ] = true
+			for i := 0; i < e.typ.NumField(); i++ {
+				f := e.typ.Field(i)
+				name, opts, _ := strings.Cut(f.Tag.Get(tag), ",")
+				if name == "-" && opts == "" {
+					continue
+				}
+				index := make([]int, len(e.index)+1)
+				copy(index, e.index)
+				index[len(e.index)] = i
+				ft := f.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if f.Anonymous && name == "" && ft.Kind() == reflect.Struct && ft != timeType {
+					next = append(next, embedded{typ: ft, index: index})
+					continue
+				}
+				if !f.IsExported() {
+					continue
+				}
+				sf := structField{name: name, index: index, omitEmpty: strings.Contains(","+opts+",", ",omitempty,"), tagged: name != ""}
+				if name == "" {
+					sf.name = f.Name
+				}
+				level = append(level, sf)
+				// a type embedded more than once at the same depth conflicts with itself
+				if count[e.typ] > 1 {
+					level = append(level, sf)
+				}
+			}
+		}
+		for _, f := range level {
+			if _, ok := byName[f.name]; !ok {
+				names = append(names, f.name)
+			}
+			byName[f.name] = append(byName[f.name], f)
+		}
+		for _, name := range names {
+			if used[name] {
+				continue
+			}
+			used[name] = true
+			if f, ok := dominantField(byName[name]); ok {
+				ret = append(ret, f)
+			}
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		x, y := ret[i].index, ret[j].index
+		for k := 0; k < len(x) && k < len(y); k++ {
+			if x[k] != y[k] {
+				return x[k] < y[k]
+			}
+		}
+		return len(x) < len(y)
+	})
+	return ret
+}
+
+// dominantField returns the field taking a name among fs, which are at the same depth.
+func dominantField(fs []structField) (structField, bool) {
+	if len(fs) == 1 {
+		return fs[0], true
+	}
+	var (
+		ret    structField
+		tagged int
+	)
+	for _, f := range fs {
+		if f.tagged {
+			ret = f
+			tagged++
+		}
+	}
+	return ret, tagged == 1
+}
+
+// isEmpty is the omitempty rule of encoding/json, structs are never empty.
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// StructToMap converts struct v, or a pointer to it, to a map keyed by the names in the struct tag, which is "json" by default.
+// Fields tagged with "-" and unexported fields are skipped. As encoding/json does, fields tagged with omitempty
+// are skipped if they are false, 0, nil pointers or interfaces, or empty arrays, maps, slices or strings,
+// but structs are never skipped.
+// Fields of embedded structs are promoted with the rules of encoding/json: a shallower field hides deeper ones,
+// and fields of the same name at the same depth hide each other unless exactly one of them is tagged.
+// Nested structs and pointers to structs are converted to nested maps,
+// time.Time and values of other types are kept as they are.
+//
+// EXAMPLE:
+//
+//	type User struct {
+//		Name    string    `json:"name"`
+//		Age     int       `json:"age,omitempty"`
+//		Created time.Time `json:"created"`
+//	}
+//	StructToMap(User{Name: "a"}) => map[string]any{"name": "a", "created": time.Time{}}
+func StructToMap(v any, opts ...Option) (map[string]any, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+	return structToMap(rv, newConfig(opts)), nil
+}
+
+func structToMap(v reflect.Value, c *config) map[string]any {
+	fs := structFields(v.Type(), c.tag)
+	ret := make(map[string]any, len(fs))
+	for _, f := range fs {
+		fv, err := v.FieldByIndexErr(f.index)
+		if err != nil {
+			// the field is promoted through a nil embedded pointer
+			continue
+		}
+		if f.omitEmpty && isEmpty(fv) {
+			continue
+		}
+		ret[f.name] = toMapValue(fv, c)
+	}
+	return ret
+}
+
+func toMapValue(v reflect.Value, c *config) any {
+	switch {
+	case v.Kind() == reflect.Struct && v.Type() != timeType:
+		return structToMap(v, c)
+	case v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Struct && v.Type().Elem() != timeType:
+		if v.IsNil() {
+			return nil
+		}
+		return structToMap(v.Elem(), c)
+	default:
+		return v.Interface()
+	}
+}
+
+// MapToStruct sets the fields of the struct pointed by p from m, which is keyed as [StructToMap] does.
+// Fields missing in m are left unchanged. A value in m is converted to the type of its field if:
+//
+//   - it is assignable, or both of them are numbers and the conversion loses nothing;
+//   - it is a string, and the field can be parsed by [Parse], e.g. time.Time, time.Duration or numbers;
+//   - it is a map[string]any and the field is a struct or a pointer to struct;
+//   - it is a []any or map[string]any whose elements can be converted to the element type of the field.
+//
+// Otherwise it returns a *FieldError wrapping ErrTypeMismatch, or the error of [Parse].
+//
+// EXAMPLE:
+//
+//	var u User
+//	err := MapToStruct(map[string]any{"name": "a", "age": 18.0, "created": "2026-10-17T00:00:00Z"}, &u)
+func MapToStruct(m map[string]any, p any, opts ...Option) error {
+	rv := reflect.ValueOf(p)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrNotStruct
+	}
+	return mapToStruct(m, rv.Elem(), "", newConfig(opts))
+}
+
+func mapToStruct(m map[string]any, v reflect.Value, path string, c *config) error {
+	for _, f := range structFields(v.Type(), c.tag) {
+		mv, ok := m[f.name]
+		if !ok {
+			continue
+		}
+		fv, err := fieldByIndexAlloc(v, f.index, path+f.name)
+		if err != nil {
+			return err
+		}
+		if err := setValue(fv, mv, path+f.name, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldByIndexAlloc is like reflect.Value.FieldByIndex, but allocates nil embedded pointers on the way.
+// Like encoding/json, it fails if a nil embedded pointer cannot be set because its type is unexported.
+func fieldByIndexAlloc(v reflect.Value, index []int, path string) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, &FieldError{
+						Field: path,
+						Err:   fmt.Errorf("cannot set embedded pointer to unexported struct: %v", v.Type().Elem()),
+					}
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, nil
+}
+
+func setValue(dst reflect.Value, src any, path string, c *config) error {
+	if src == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	sv := reflect.ValueOf(src)
+	dt := dst.Type()
+	if sv.Type().AssignableTo(dt) {
+		dst.Set(sv)
+		return nil
+	}
+	if dt.Kind() == reflect.Ptr {
+		nv := reflect.New(dt.Elem())
+		if err := setValue(nv.Elem(), src, path, c); err != nil {
+			return err
+		}
+		dst.Set(nv)
+		return nil
+	}
+	mismatch := &FieldError{Field: path, Err: fmt.Errorf("%w: cannot assign %T to %s", ErrTypeMismatch, src, dt)}
+
+	switch s := src.(type) {
+	case string:
+		nv := reflect.New(dt)
+		if err := parse(s, nv.Interface(), c); err != nil {
+			if errors.Is(err, ErrUnsupportedType) {
+				return mismatch
+			}
+			return &FieldError{Field: path, Err: err}
+		}
+		dst.Set(nv.Elem())
+		return nil
+	case map[string]any:
+		switch dt.Kind() {
+		case reflect.Struct:
+			if dt == timeType {
+				return mismatch
+			}
+			return mapToStruct(s, dst, path+".", c)
+		case reflect.Map:
+			if dt.Key().Kind() != reflect.String {
+				return mismatch
+			}
+			nm := reflect.MakeMapWithSize(dt, len(s))
+			for k, e := range s {
+				ne := reflect.New(dt.Elem()).Elem()
+				if err := setValue(ne, e, path+"."+k, c); err != nil {
+					return err
+				}
+				nm.SetMapIndex(reflect.ValueOf(k).Convert(dt.Key()), ne)
+			}
+			dst.Set(nm)
+			return nil
+		}
+	case []any:
+		if dt.Kind() != reflect.Slice {
+			return mismatch
+		}
+		ns := reflect.MakeSlice(dt, len(s), len(s))
+		for i, e := range s {
+			if err := setValue(ns.Index(i), e, fmt.Sprintf("%s[%d]", path, i), c); err != nil {
+				return err
+			}
+		}
+		dst.Set(ns)
+		return nil
+	}
+
+	if isNumber(sv.Kind()) && isNumber(dt.Kind()) {
+		nv := sv.Convert(dt)
+		if err := checkNumber(sv, nv); err != nil {
+			return &FieldError{Field: path, Err: fmt.Errorf("%w: %v to %s", err, src, dt)}
+		}
+		dst.Set(nv)
+		return nil
+	}
+	return mismatch
+}
+
+func isNumber(k reflect.Kind) bool {
+	return isInt(k) || isUint(k) || isFloatKind(k)
+}
+
+func isInt(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUint(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+// checkNumber checks the conversion of number src to r in the same way as [Convert].
+func checkNumber(src, r reflect.Value) error {
+	sk, rk := src.Kind(), r.Kind()
+	switch {
+	case isFloatKind(sk) && isFloatKind(rk):
+		return checkFloatToFloat(src.Float(), r.Float())
+	case isFloatKind(sk):
+		return checkFloatToInt(src.Float(), r.Type().Bits(), isInt(rk))
+	case isFloatKind(rk):
+		if isInt(sk) {
+			i := src.Int()
+			u := uint64(i)
+			if i < 0 {
+				u = uint64(-i)
+			}
+			return checkIntToFloat(u, r.Type().Bits())
+		}
+		return checkIntToFloat(src.Uint(), r.Type().Bits())
+	case isInt(sk) && src.Int() < 0 && isUint(rk):
+		return ErrSignLoss
+	case r.Convert(src.Type()).Interface() != src.Interface() || (isInt(rk) && isUint(sk) && r.Int() < 0):
+		return ErrOverflow
+	}
+	return nil
+}
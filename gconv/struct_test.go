@@ -0,0 +1,285 @@
+// Package gconv
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gconv_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyphennn/glambda/gconv"
+	"github.com/hyphennn/glambda/gmap"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+type Base struct {
+	ID      int64     `json:"id"`
+	Created time.Time `json:"created"`
+}
+
+type Address struct {
+	City string `json:"city" db:"city_name"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type Account struct {
+	Base
+	*Extra
+	Name     string            `json:"name" db:"user_name"`
+	Age      int               `json:"age,omitempty"`
+	Tags     []string          `json:"tags,omitempty"`
+	Address  Address           `json:"address"`
+	Backup   *Address          `json:"backup"`
+	Timeout  time.Duration     `json:"timeout"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Password string            `json:"-"`
+	secret   string
+}
+
+type Extra struct {
+	Note string `json:"note"`
+	// shadowed by Account.Name
+	Name string `json:"name"`
+}
+
+func TestStructToMap(t *testing.T) {
+	created := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	a := Account{
+		Base:     Base{ID: 1, Created: created},
+		Name:     "a",
+		Address:  Address{City: "x"},
+		Timeout:  time.Second,
+		Password: "p",
+		secret:   "s",
+	}
+	m, err := gconv.StructToMap(&a)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{
+		"id":      int64(1),
+		"created": created,
+		"name":    "a",
+		"address": map[string]any{"city": "x"},
+		"backup":  nil,
+		"timeout": time.Second,
+	}, m)
+
+	a.Extra = &Extra{Note: "n", Name: "shadowed"}
+	a.Backup = &Address{City: "y", Zip: "1"}
+	a.Tags = []string{}
+	m, _ = gconv.StructToMap(a)
+	assert.Equal(t, "n", m["note"])
+	assert.Equal(t, "a", m["name"])
+	assert.Equal(t, any(map[string]any{"city": "y", "zip": "1"}), m["backup"])
+	_, ok := m["tags"]
+	assert.False(t, ok)
+
+	m, _ = gconv.StructToMap(Address{City: "x"}, gconv.WithTag("db"))
+	assert.Equal(t, map[string]any{"city_name": "x", "Zip": ""}, m)
+
+	// the result works with gmap directly
+	cols := gmap.Map(m, func(k string, v any) (string, string) { return "t." + k, v.(string) })
+	assert.Equal(t, map[string]string{"t.city_name": "x", "t.Zip": ""}, cols)
+
+	_, err = gconv.StructToMap(1)
+	assert.Equal(t, gconv.ErrNotStruct, err)
+	_, err = gconv.StructToMap((*Account)(nil))
+	assert.Equal(t, gconv.ErrNotStruct, err)
+}
+
+func TestMapToStruct(t *testing.T) {
+	var a Account
+	err := gconv.MapToStruct(map[string]any{
+		"id":      1.0,
+		"created": "2026-10-17T00:00:00Z",
+		"name":    "a",
+		"age":     int8(18),
+		"tags":    []any{"x", "y"},
+		"address": map[string]any{"city": "c"},
+		"backup":  map[string]any{"zip": "z"},
+		"timeout": "1m",
+		"labels":  map[string]any{"k": "v"},
+		"note":    "n",
+		"unknown": 1,
+	}, &a)
+	assert.Nil(t, err)
+	assert.Equal(t, Account{
+		Base:    Base{ID: 1, Created: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		Extra:   &Extra{Note: "n"},
+		Name:    "a",
+		Age:     18,
+		Tags:    []string{"x", "y"},
+		Address: Address{City: "c"},
+		Backup:  &Address{Zip: "z"},
+		Timeout: time.Minute,
+		Labels:  map[string]string{"k": "v"},
+	}, a)
+
+	assert.Nil(t, gconv.MapToStruct(map[string]any{"backup": nil, "timeout": time.Second}, &a))
+	assert.True(t, a.Backup == nil)
+	assert.Equal(t, time.Second, a.Timeout)
+
+	var addr Address
+	assert.Nil(t, gconv.MapToStruct(map[string]any{"city_name": "x"}, &addr, gconv.WithTag("db")))
+	assert.Equal(t, "x", addr.City)
+}
+
+func TestMapToStructRoundTrip(t *testing.T) {
+	a := Account{Base: Base{ID: 2}, Name: "b", Backup: &Address{City: "c"}, Tags: []string{"t"}}
+	m, err := gconv.StructToMap(a)
+	assert.Nil(t, err)
+	var b Account
+	assert.Nil(t, gconv.MapToStruct(m, &b))
+	assert.Equal(t, a, b)
+
+	// the map decoded from JSON works as well
+	bs, _ := json.Marshal(a)
+	var jm map[string]any
+	assert.Nil(t, json.Unmarshal(bs, &jm))
+	var c Account
+	assert.Nil(t, gconv.MapToStruct(jm, &c))
+	assert.Equal(t, a, c)
+}
+
+func TestMapToStructError(t *testing.T) {
+	var a Account
+	err := gconv.MapToStruct(map[string]any{"age": 1.5}, &a)
+	var fe *gconv.FieldError
+	assert.True(t, errors.As(err, &fe))
+	assert.Equal(t, "age", fe.Field)
+	assert.True(t, errors.Is(err, gconv.ErrTruncated))
+
+	err = gconv.MapToStruct(map[string]any{"address": map[string]any{"city": 1}}, &a)
+	assert.True(t, errors.As(err, &fe))
+	assert.Equal(t, "address.city", fe.Field)
+	assert.True(t, errors.Is(err, gconv.ErrTypeMismatch))
+	assert.Equal(t, "gconv: field address.city: type mismatch: cannot assign int to string", err.Error())
+
+	err = gconv.MapToStruct(map[string]any{"tags": []any{"a", 1}}, &a)
+	assert.True(t, errors.As(err, &fe))
+	assert.Equal(t, "tags[1]", fe.Field)
+
+	err = gconv.MapToStruct(map[string]any{"timeout": "x"}, &a)
+	assert.True(t, errors.As(err, &fe))
+	assert.Equal(t, "timeout", fe.Field)
+
+	err = gconv.MapToStruct(map[string]any{"id": -1}, &struct {
+		ID uint `json:"id"`
+	}{})
+	assert.True(t, errors.Is(err, gconv.ErrSignLoss))
+
+	err = gconv.MapToStruct(map[string]any{"id": 300}, &struct {
+		ID uint8 `json:"id"`
+	}{})
+	assert.True(t, errors.Is(err, gconv.ErrOverflow))
+
+	assert.Equal(t, gconv.ErrNotStruct, gconv.MapToStruct(nil, a))
+	assert.Equal(t, gconv.ErrNotStruct, gconv.MapToStruct(nil, (*Account)(nil)))
+}
+
+type inner struct {
+	ID int `json:"id"`
+}
+
+type Outer struct {
+	*inner
+	Name string `json:"name"`
+}
+
+func TestMapToStructUnexportedEmbedded(t *testing.T) {
+	// 未导出类型的嵌入指针为 nil 时无法分配，返回错误而不是 panic
+	var o Outer
+	err := gconv.MapToStruct(map[string]any{"id": 1, "name": "a"}, &o)
+	var fe *gconv.FieldError
+	assert.True(t, errors.As(err, &fe))
+	assert.Equal(t, "id", fe.Field)
+	assert.Equal(t, "gconv: field id: cannot set embedded pointer to unexported struct: gconv_test.inner", err.Error())
+
+	// 已分配时可以设置提升的字段
+	o = Outer{inner: &inner{}}
+	assert.Nil(t, gconv.MapToStruct(map[string]any{"id": 1, "name": "a"}, &o))
+	assert.Equal(t, 1, o.ID)
+	assert.Equal(t, "a", o.Name)
+
+	// 与 encoding/json 行为一致
+	assert.NotNil(t, json.Unmarshal([]byte(`{"id":1}`), &Outer{}))
+}
+
+type Node struct {
+	*Node
+	V int `json:"v"`
+}
+
+func TestStructFieldsSelfEmbedding(t *testing.T) {
+	// 嵌入自身指针的类型只访问一次，不会无限递归
+	m, err := gconv.StructToMap(Node{V: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"v": 1}, m)
+
+	var n Node
+	assert.Nil(t, gconv.MapToStruct(map[string]any{"v": 2}, &n))
+	assert.Equal(t, 2, n.V)
+}
+
+type ConflictA struct {
+	Name string
+	ID   int `json:"id"`
+}
+
+type ConflictB struct {
+	Name string
+	ID   int
+}
+
+type ConflictC struct {
+	Name  string
+	Label string `json:"Name"`
+}
+
+type Conflict struct {
+	ConflictA
+	ConflictB
+	Age int `json:"age,omitempty"`
+}
+
+type OmitStruct struct {
+	Base    Base      `json:"base,omitempty"`
+	Created time.Time `json:"created,omitempty"`
+	Ptr     *Base     `json:"ptr,omitempty"`
+	Flag    bool      `json:"flag,omitempty"`
+}
+
+func TestStructFieldsLikeJSON(t *testing.T) {
+	// 同一深度的同名字段互相隐藏，除非只有一个带 tag
+	c := Conflict{ConflictA: ConflictA{Name: "a", ID: 1}, ConflictB: ConflictB{Name: "b", ID: 2}}
+	m, err := gconv.StructToMap(c)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"id": 1, "ID": 2}, m)
+	bs, _ := json.Marshal(c)
+	assert.Equal(t, `{"id":1,"ID":2}`, string(bs))
+
+	var c2 Conflict
+	assert.Nil(t, gconv.MapToStruct(map[string]any{"Name": "x", "id": 3, "ID": 4}, &c2))
+	assert.Equal(t, Conflict{ConflictA: ConflictA{ID: 3}, ConflictB: ConflictB{ID: 4}}, c2)
+
+	// 唯一带 tag 的字段胜出
+	type tagWins struct {
+		ConflictA
+		ConflictC
+	}
+	m, err = gconv.StructToMap(tagWins{ConflictA{Name: "a"}, ConflictC{Name: "c", Label: "l"}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"id": 0, "Name": "l"}, m)
+
+	// omitempty 不会省略结构体
+	m, err = gconv.StructToMap(OmitStruct{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(m))
+	assert.Equal(t, any(time.Time{}), m["created"])
+	_, ok := m["base"]
+	assert.True(t, ok)
+	bs, _ = json.Marshal(OmitStruct{})
+	assert.Equal(t, `{"base":{"id":0,"created":"0001-01-01T00:00:00Z"},"created":"0001-01-01T00:00:00Z"}`, string(bs))
+}
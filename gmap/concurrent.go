@@ -0,0 +1,348 @@
+// Package gmap
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gmap
+
+import (
+	"hash/fnv"
+	"math"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// DefaultShardCount is the number of shards used by [NewConcurrentMap].
+const DefaultShardCount = 32
+
+// ConcurrentMap is a map safe for concurrent use, its keys are spread over
+// several shards each guarded by its own lock.
+// Use [NewConcurrentMap] or [NewConcurrentMapWithHasher] to create one.
+type ConcurrentMap[K comparable, V any] struct {
+	shards []*shard[K, V]
+	hasher func(K) uint64
+}
+
+type shard[K comparable, V any] struct {
+	sync.RWMutex
+	m map[K]V
+}
+
+// NewConcurrentMap returns an empty ConcurrentMap with [DefaultShardCount] shards and the default hash function.
+//
+// The default hash function reads keys of basic underlying types (strings, booleans and numbers,
+// including named ones such as type UserID int64) directly, and hashes composite keys
+// (structs, arrays, pointers and interfaces) field by field with reflection, treating +0 and -0 as equal.
+// Use [NewConcurrentMapWithHasher] if the default one is too slow for composite keys.
+//
+// EXAMPLE:
+//
+//	m := NewConcurrentMap[string, int]()
+//	m.Store("a", 1)
+//	m.Load("a") => (1, true)
+func NewConcurrentMap[K comparable, V any]() *ConcurrentMap[K, V] {
+	return NewConcurrentMapWithHasher[K, V](DefaultShardCount, nil)
+}
+
+// NewConcurrentMapWithHasher returns an empty ConcurrentMap with shardCount shards and hash function hasher.
+// A non-positive shardCount means [DefaultShardCount], a nil hasher means the default hash function.
+//
+// EXAMPLE:
+//
+//	m := NewConcurrentMapWithHasher[UserID, *User](64, func(id UserID) uint64 { return uint64(id) })
+func NewConcurrentMapWithHasher[K comparable, V any](shardCount int, hasher func(K) uint64) *ConcurrentMap[K, V] {
+	if shardCount <= 0 {
+		shardCount = DefaultShardCount
+	}
+	if hasher == nil {
+		hasher = newDefaultHasher[K]()
+	}
+	ret := &ConcurrentMap[K, V]{
+		shards: make([]*shard[K, V], shardCount),
+		hasher: hasher,
+	}
+	for i := range ret.shards {
+		ret.shards[i] = &shard[K, V]{m: make(map[K]V)}
+	}
+	return ret
+}
+
+// newDefaultHasher returns the default hash function for K, chosen once by the kind of K.
+// Keys whose underlying type is basic (such as type UserID int64) are read in place without reflection,
+// composite keys are hashed field by field with reflection.
+func newDefaultHasher[K comparable]() func(K) uint64 {
+	var zk K
+	t := reflect.TypeOf(&zk).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		return func(k K) uint64 { return fnvString(*(*string)(unsafe.Pointer(&k))) }
+	case reflect.Int:
+		return func(k K) uint64 { return mix(uint64(*(*int)(unsafe.Pointer(&k)))) }
+	case reflect.Int8:
+		return func(k K) uint64 { return mix(uint64(*(*int8)(unsafe.Pointer(&k)))) }
+	case reflect.Int16:
+		return func(k K) uint64 { return mix(uint64(*(*int16)(unsafe.Pointer(&k)))) }
+	case reflect.Int32:
+		return func(k K) uint64 { return mix(uint64(*(*int32)(unsafe.Pointer(&k)))) }
+	case reflect.Int64:
+		return func(k K) uint64 { return mix(uint64(*(*int64)(unsafe.Pointer(&k)))) }
+	case reflect.Uint:
+		return func(k K) uint64 { return mix(uint64(*(*uint)(unsafe.Pointer(&k)))) }
+	case reflect.Uint8:
+		return func(k K) uint64 { return mix(uint64(*(*uint8)(unsafe.Pointer(&k)))) }
+	case reflect.Uint16:
+		return func(k K) uint64 { return mix(uint64(*(*uint16)(unsafe.Pointer(&k)))) }
+	case reflect.Uint32:
+		return func(k K) uint64 { return mix(uint64(*(*uint32)(unsafe.Pointer(&k)))) }
+	case reflect.Uint64:
+		return func(k K) uint64 { return mix(*(*uint64)(unsafe.Pointer(&k))) }
+	case reflect.Uintptr:
+		return func(k K) uint64 { return mix(uint64(*(*uintptr)(unsafe.Pointer(&k)))) }
+	case reflect.Float32:
+		return func(k K) uint64 { return hashFloat(float64(*(*float32)(unsafe.Pointer(&k)))) }
+	case reflect.Float64:
+		return func(k K) uint64 { return hashFloat(*(*float64)(unsafe.Pointer(&k))) }
+	case reflect.Bool:
+		return func(k K) uint64 {
+			if *(*bool)(unsafe.Pointer(&k)) {
+				return 1
+			}
+			return 0
+		}
+	default:
+		return func(k K) uint64 { return hashValue(reflect.ValueOf(&k).Elem()) }
+	}
+}
+
+// hashValue hashes v so that values equal by == get the same hash.
+func hashValue(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.String:
+		return fnvString(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mix(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return mix(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return combine(hashFloat(real(c)), hashFloat(imag(c)))
+	case reflect.Bool:
+		if v.Bool() {
+			return 1
+		}
+		return 0
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		return mix(uint64(v.Pointer()))
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		// the dynamic type takes part in ==, but equal hashes for different types are harmless
+		return hashValue(v.Elem())
+	case reflect.Array:
+		var h uint64
+		for i := 0; i < v.Len(); i++ {
+			h = combine(h, hashValue(v.Index(i)))
+		}
+		return h
+	case reflect.Struct:
+		var h uint64
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			// blank fields are ignored by ==
+			if t.Field(i).Name == "_" {
+				continue
+			}
+			h = combine(h, hashValue(v.Field(i)))
+		}
+		return h
+	default:
+		// not reachable for comparable types
+		panic("gmap: unhashable key type " + v.Type().String())
+	}
+}
+
+// combine folds hash x into the running hash h.
+func combine(h, x uint64) uint64 {
+	return mix(h^x) + 0x9e3779b97f4a7c15
+}
+
+func fnvString(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// mix scrambles the bits of x so that sequential integers are spread over shards.
+func mix(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	return x
+}
+
+func hashFloat(f float64) uint64 {
+	// +0 and -0 are equal keys
+	if f == 0 {
+		return 0
+	}
+	return mix(math.Float64bits(f))
+}
+
+func (c *ConcurrentMap[K, V]) shard(k K) *shard[K, V] {
+	return c.shards[c.hasher(k)%uint64(len(c.shards))]
+}
+
+// Load returns the value stored for key k, or the zero value of V and false if there is none.
+func (c *ConcurrentMap[K, V]) Load(k K) (V, bool) {
+	s := c.shard(k)
+	s.RLock()
+	v, ok := s.m[k]
+	s.RUnlock()
+	return v, ok
+}
+
+// Store sets the value for key k.
+func (c *ConcurrentMap[K, V]) Store(k K, v V) {
+	s := c.shard(k)
+	s.Lock()
+	s.m[k] = v
+	s.Unlock()
+}
+
+// LoadOrStore returns the existing value for key k if present and true.
+// Otherwise, it stores v and returns v and false.
+func (c *ConcurrentMap[K, V]) LoadOrStore(k K, v V) (V, bool) {
+	s := c.shard(k)
+	s.Lock()
+	defer s.Unlock()
+	if old, ok := s.m[k]; ok {
+		return old, true
+	}
+	s.m[k] = v
+	return v, false
+}
+
+// LoadAndDelete deletes the value for key k, returning the previous value if any and whether it was present.
+func (c *ConcurrentMap[K, V]) LoadAndDelete(k K) (V, bool) {
+	s := c.shard(k)
+	s.Lock()
+	v, ok := s.m[k]
+	delete(s.m, k)
+	s.Unlock()
+	return v, ok
+}
+
+// Delete deletes the value for key k.
+func (c *ConcurrentMap[K, V]) Delete(k K) {
+	s := c.shard(k)
+	s.Lock()
+	delete(s.m, k)
+	s.Unlock()
+}
+
+// Compute atomically updates the value for key k.
+// fc receives the current value and whether it is present, and returns the new value
+// and whether to keep it: returning false deletes key k.
+// It returns the new value and whether key k is present afterwards.
+// fc is called under the lock of the shard, it must not access the map.
+//
+// EXAMPLE:
+//
+//	// increase a counter
+//	m.Compute("a", func(old int, ok bool) (int, bool) { return old + 1, true })
+//	// delete if value is 0
+//	m.Compute("a", func(old int, ok bool) (int, bool) { return old, old != 0 })
+func (c *ConcurrentMap[K, V]) Compute(k K, fc func(old V, ok bool) (V, bool)) (V, bool) {
+	s := c.shard(k)
+	s.Lock()
+	defer s.Unlock()
+	old, ok := s.m[k]
+	v, keep := fc(old, ok)
+	if !keep {
+		delete(s.m, k)
+		var zero V
+		return zero, false
+	}
+	s.m[k] = v
+	return v, true
+}
+
+// ComputeIfAbsent returns the value for key k if present,
+// otherwise it stores and returns the result of fc.
+// fc is called under the lock of the shard, it must not access the map.
+//
+// EXAMPLE:
+//
+//	m.ComputeIfAbsent("a", func(k string) []int { return make([]int, 0) })
+func (c *ConcurrentMap[K, V]) ComputeIfAbsent(k K, fc func(K) V) V {
+	s := c.shard(k)
+	if v, ok := c.Load(k); ok {
+		return v
+	}
+	s.Lock()
+	defer s.Unlock()
+	if v, ok := s.m[k]; ok {
+		return v
+	}
+	v := fc(k)
+	s.m[k] = v
+	return v
+}
+
+// Range calls fc for each key and value until fc returns false.
+// Like sync.Map, Range does not block other operations: each shard is snapshotted
+// before fc is called, so fc may modify the map, and it is not a consistent snapshot
+// of the whole map.
+func (c *ConcurrentMap[K, V]) Range(fc func(K, V) bool) {
+	for _, s := range c.shards {
+		s.RLock()
+		snapshot := Clone(s.m)
+		s.RUnlock()
+		for k, v := range snapshot {
+			if !fc(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of keys. It is not atomic across shards.
+func (c *ConcurrentMap[K, V]) Len() int {
+	n := 0
+	for _, s := range c.shards {
+		s.RLock()
+		n += len(s.m)
+		s.RUnlock()
+	}
+	return n
+}
+
+// Clear deletes all keys.
+func (c *ConcurrentMap[K, V]) Clear() {
+	for _, s := range c.shards {
+		s.Lock()
+		s.m = make(map[K]V)
+		s.Unlock()
+	}
+}
+
+// ToMap returns a copy of the map as a plain map, so that it can be used with the other functions of gmap.
+// Each shard is copied under its lock, but the copy is not atomic across shards.
+//
+// EXAMPLE:
+//
+//	CollectKey(m.ToMap())
+func (c *ConcurrentMap[K, V]) ToMap() map[K]V {
+	ret := make(map[K]V, c.Len())
+	for _, s := range c.shards {
+		s.RLock()
+		for k, v := range s.m {
+			ret[k] = v
+		}
+		s.RUnlock()
+	}
+	return ret
+}
@@ -0,0 +1,163 @@
+// Package gmap
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gmap_test
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hyphennn/glambda/gmap"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	m := gmap.NewConcurrentMap[string, int]()
+	m.Store("a", 1)
+	v, ok := m.Load("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	_, ok = m.Load("b")
+	assert.False(t, ok)
+
+	v, loaded := m.LoadOrStore("a", 2)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, v)
+	v, loaded = m.LoadOrStore("b", 2)
+	assert.False(t, loaded)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 2, m.Len())
+
+	v, ok = m.LoadAndDelete("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	_, ok = m.LoadAndDelete("a")
+	assert.False(t, ok)
+
+	m.Delete("b")
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestConcurrentMapCompute(t *testing.T) {
+	m := gmap.NewConcurrentMap[string, int]()
+	incr := func(old int, ok bool) (int, bool) { return old + 1, true }
+	m.Compute("a", incr)
+	v, ok := m.Compute("a", incr)
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	_, ok = m.Compute("a", func(old int, ok bool) (int, bool) { return old, false })
+	assert.False(t, ok)
+	assert.Equal(t, 0, m.Len())
+
+	calls := 0
+	newV := func(k string) int { calls++; return len(k) }
+	assert.Equal(t, 3, m.ComputeIfAbsent("abc", newV))
+	assert.Equal(t, 3, m.ComputeIfAbsent("abc", newV))
+	assert.Equal(t, 1, calls)
+}
+
+func TestConcurrentMapRange(t *testing.T) {
+	m := gmap.NewConcurrentMapWithHasher[int, int](4, func(k int) uint64 { return uint64(k) })
+	for i := 0; i < 10; i++ {
+		m.Store(i, i*i)
+	}
+	n := 0
+	m.Range(func(k, v int) bool {
+		assert.Equal(t, k*k, v)
+		// 在 Range 中修改不会死锁
+		m.Store(k+100, v)
+		n++
+		return n < 5
+	})
+	assert.Equal(t, 5, n)
+
+	m.Clear()
+	m.Store(1, 1)
+	m.Store(2, 4)
+	assert.Equal(t, map[int]int{1: 1, 2: 4}, m.ToMap())
+	keys := gmap.CollectKey(m.ToMap())
+	sort.Ints(keys)
+	assert.Equal(t, []int{1, 2}, keys)
+}
+
+func TestConcurrentMapDefaultHasher(t *testing.T) {
+	type key struct {
+		A int
+		B string
+	}
+	m := gmap.NewConcurrentMap[key, int]()
+	m.Store(key{1, "a"}, 1)
+	v, ok := m.Load(key{1, "a"})
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	f := gmap.NewConcurrentMap[float64, int]()
+	f.Store(0, 1)
+	f.Store(math.Copysign(0, -1), 2)
+	assert.Equal(t, 1, f.Len())
+
+	// 复合 key 中的浮点数同样视 +0 与 -0 为相等
+	type fkey struct {
+		F float64
+		A [2]float32
+	}
+	fk := gmap.NewConcurrentMap[fkey, int]()
+	for i := 0; i < 64; i++ {
+		fk.Store(fkey{}, 1)
+		fk.Store(fkey{F: math.Copysign(0, -1), A: [2]float32{float32(math.Copysign(0, -1))}}, 2)
+	}
+	assert.Equal(t, 1, fk.Len())
+
+	// 具名基础类型
+	type UserID int64
+	u := gmap.NewConcurrentMap[UserID, string]()
+	for i := UserID(0); i < 100; i++ {
+		u.Store(i, "u")
+	}
+	assert.Equal(t, 100, u.Len())
+	v2, ok := u.Load(42)
+	assert.True(t, ok)
+	assert.Equal(t, "u", v2)
+
+	// 指针 key 按地址比较
+	type pkey struct {
+		P *int
+		S string
+	}
+	x, y := new(int), new(int)
+	p := gmap.NewConcurrentMap[pkey, int]()
+	p.Store(pkey{x, "a"}, 1)
+	p.Store(pkey{y, "a"}, 2)
+	p.Store(pkey{x, "a"}, 3)
+	assert.Equal(t, 2, p.Len())
+	v3, _ := p.Load(pkey{x, "a"})
+	assert.Equal(t, 3, v3)
+}
+
+func TestConcurrentMapParallel(t *testing.T) {
+	m := gmap.NewConcurrentMap[string, int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				k := strconv.Itoa(i % 100)
+				m.Compute(k, func(old int, ok bool) (int, bool) { return old + 1, true })
+				m.Load(k)
+				m.LoadOrStore("g"+strconv.Itoa(g), g)
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, 108, m.Len())
+	for i := 0; i < 100; i++ {
+		v, _ := m.Load(strconv.Itoa(i))
+		assert.Equal(t, 80, v)
+	}
+}
@@ -1,6 +1,7 @@
 package gmap
 
 import (
+	"github.com/hyphennn/glambda/goption"
 	"github.com/hyphennn/glambda/gutils"
 )
 
@@ -54,6 +55,18 @@ func Reverse[K, V comparable](m map[K]V) map[V]K {
 	return ret
 }
 
+// GetOpt looks up key k in map m and returns the value as a [goption.Option].
+//
+// EXAMPLE:
+//
+//	m := map[int]string{1: "a"}
+//	GetOpt(m, 1) => goption.Some("a")
+//	GetOpt(m, 2) => goption.None[string]()
+func GetOpt[K comparable, V any](m map[K]V, k K) goption.Option[V] {
+	v, ok := m[k]
+	return goption.Of(v, ok)
+}
+
 // SafeStore safely stores a key-value pair in map m.
 // If m is nil, it initializes the map.
 //
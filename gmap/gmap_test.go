@@ -110,3 +110,22 @@ func TestClone(t *testing.T) {
 	cloned := gmap.Clone(m)
 	assert.Equal(t, m, cloned)
 }
+
+func TestGetOpt(t *testing.T) {
+	m := map[int]string{1: "a"}
+	assert.Equal(t, "a", gmap.GetOpt(m, 1).MustGet())
+	assert.True(t, gmap.GetOpt(m, 2).IsNone())
+	assert.True(t, gmap.GetOpt[int, string](nil, 1).IsNone())
+}
+
+func TestSortedKeys(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, gmap.SortedKeys(map[int]string{2: "b", 1: "a", 3: "c"}))
+	assert.Equal(t, []string{}, gmap.SortedKeys(map[string]int{}))
+}
+
+func TestSortedEntries(t *testing.T) {
+	assert.Equal(t,
+		[]gutils.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}},
+		gmap.SortedEntries(map[int]string{2: "b", 1: "a"}),
+	)
+}
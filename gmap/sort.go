@@ -0,0 +1,46 @@
+// Package gmap
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gmap
+
+import (
+	"sort"
+
+	"github.com/hyphennn/glambda/gutils"
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+// SortedKeys collects all keys from map m into a slice sorted in ascending order.
+//
+// EXAMPLE:
+//
+//	m := map[int]string{2: "b", 1: "a", 3: "c"}
+//	SortedKeys(m) => []int{1, 2, 3}
+//
+// HINT:
+//
+//   - Use [CollectKey] if the order does not matter.
+func SortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
+	ks := CollectKey(m)
+	sort.Slice(ks, func(i, j int) bool {
+		return ks[i] < ks[j]
+	})
+	return ks
+}
+
+// SortedEntries collects all key-value pairs from map m into a slice sorted by key in ascending order.
+//
+// EXAMPLE:
+//
+//	m := map[int]string{2: "b", 1: "a"}
+//	SortedEntries(m) => []gutils.Pair[int, string]{{1, "a"}, {2, "b"}}
+func SortedEntries[K constraints.Ordered, V any](m map[K]V) []gutils.Pair[K, V] {
+	ret := ToSlice(m, func(k K, v V) gutils.Pair[K, V] {
+		return gutils.Pair[K, V]{First: k, Second: v}
+	})
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].First < ret[j].First
+	})
+	return ret
+}
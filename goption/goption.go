@@ -0,0 +1,200 @@
+// Package goption
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package goption
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Option is an optional value of type T, it is either Some(value) or None.
+// The zero value of Option is None.
+type Option[T any] struct {
+	v  T
+	ok bool
+}
+
+// Some returns an Option holding value v.
+//
+// EXAMPLE:
+//
+//	Some(1).Get() => (1, true)
+func Some[T any](v T) Option[T] {
+	return Option[T]{v: v, ok: true}
+}
+
+// None returns an empty Option.
+//
+// EXAMPLE:
+//
+//	None[int]().Get() => (0, false)
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// Of converts the (T, bool) pair returned by most lookup functions to an Option.
+//
+// EXAMPLE:
+//
+//	Of(gslice.First(s, fc))   => Some(v) if found, otherwise None
+//	Of(strconv.Itoa(1), true) => Some("1")
+func Of[T any](v T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// OfPtr returns Some(*p) if p is not nil, otherwise None.
+//
+// EXAMPLE:
+//
+//	OfPtr(gconv.ToPtr(1)) => Some(1)
+//	OfPtr[int](nil)       => None
+func OfPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// IsSome returns true if o holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if o holds no value.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true if o holds a value, otherwise the zero value of T and false.
+func (o Option[T]) Get() (T, bool) {
+	return o.v, o.ok
+}
+
+// Value returns the value of o, or the zero value of T if o is None.
+func (o Option[T]) Value() T {
+	return o.v
+}
+
+// MustGet returns the value of o, it panics if o is None.
+func (o Option[T]) MustGet() T {
+	if !o.ok {
+		panic(fmt.Sprintf("goption: MustGet called on None of %T", o.v))
+	}
+	return o.v
+}
+
+// OrElse returns the value of o, or def if o is None.
+//
+// EXAMPLE:
+//
+//	Some(1).OrElse(2)     => 1
+//	None[int]().OrElse(2) => 2
+func (o Option[T]) OrElse(def T) T {
+	if !o.ok {
+		return def
+	}
+	return o.v
+}
+
+// OrElseGet returns the value of o, or the result of fc if o is None.
+// fc is only called when o is None.
+//
+// EXAMPLE:
+//
+//	None[int]().OrElseGet(func() int { return 2 }) => 2
+func (o Option[T]) OrElseGet(fc func() T) T {
+	if !o.ok {
+		return fc()
+	}
+	return o.v
+}
+
+// Filter returns o if o holds a value for which fc returns true, otherwise None.
+//
+// EXAMPLE:
+//
+//	Some(2).Filter(func(i int) bool { return i%2 == 0 }) => Some(2)
+//	Some(1).Filter(func(i int) bool { return i%2 == 0 }) => None
+func (o Option[T]) Filter(fc func(T) bool) Option[T] {
+	if !o.ok || !fc(o.v) {
+		return None[T]()
+	}
+	return o
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if o is None.
+func (o Option[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+	v := o.v
+	return &v
+}
+
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.v)
+}
+
+// MarshalJSON encodes None as null and Some(v) as the encoding of v.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.v)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// Map applies function fc to the value of o, None is returned as is.
+//
+// EXAMPLE:
+//
+//	Map(Some(1), strconv.Itoa)     => Some("1")
+//	Map(None[int](), strconv.Itoa) => None
+func Map[F, T any](o Option[F], fc func(F) T) Option[T] {
+	if !o.ok {
+		return None[T]()
+	}
+	return Some(fc(o.v))
+}
+
+// FlatMap applies function fc to the value of o and returns its result, None is returned as is.
+//
+// EXAMPLE:
+//
+//	FlatMap(Some("1"), func(s string) Option[int] { v, ok := m[s]; return Of(v, ok) })
+func FlatMap[F, T any](o Option[F], fc func(F) Option[T]) Option[T] {
+	if !o.ok {
+		return None[T]()
+	}
+	return fc(o.v)
+}
+
+// Equal returns true if a and b are both None, or both hold equal values.
+func Equal[T comparable](a, b Option[T]) bool {
+	if a.ok != b.ok {
+		return false
+	}
+	return !a.ok || a.v == b.v
+}
@@ -0,0 +1,91 @@
+// Package goption
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package goption_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/hyphennn/glambda/goption"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestOption(t *testing.T) {
+	some := goption.Some(1)
+	v, ok := some.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.True(t, some.IsSome())
+	assert.False(t, some.IsNone())
+	assert.Equal(t, 1, some.MustGet())
+	assert.Equal(t, 1, *some.Ptr())
+
+	none := goption.None[int]()
+	v, ok = none.Get()
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+	assert.True(t, none.IsNone())
+	assert.Equal(t, 0, none.Value())
+	assert.True(t, none.Ptr() == nil)
+	assert.Panic(t, func() { none.MustGet() })
+
+	var zero goption.Option[int]
+	assert.True(t, zero.IsNone())
+
+	assert.True(t, goption.Of(1, true).IsSome())
+	assert.True(t, goption.Of(1, false).IsNone())
+	assert.True(t, goption.OfPtr[int](nil).IsNone())
+	assert.Equal(t, "Some(1)", some.String())
+	assert.Equal(t, "None", none.String())
+}
+
+func TestOrElse(t *testing.T) {
+	assert.Equal(t, 1, goption.Some(1).OrElse(2))
+	assert.Equal(t, 2, goption.None[int]().OrElse(2))
+
+	called := false
+	assert.Equal(t, 1, goption.Some(1).OrElseGet(func() int { called = true; return 2 }))
+	assert.False(t, called)
+	assert.Equal(t, 2, goption.None[int]().OrElseGet(func() int { return 2 }))
+}
+
+func TestTransform(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+	assert.True(t, goption.Equal(goption.Some(2), goption.Some(2).Filter(even)))
+	assert.True(t, goption.Some(1).Filter(even).IsNone())
+
+	assert.True(t, goption.Equal(goption.Some("1"), goption.Map(goption.Some(1), strconv.Itoa)))
+	assert.True(t, goption.Map(goption.None[int](), strconv.Itoa).IsNone())
+
+	atoi := func(s string) goption.Option[int] {
+		i, err := strconv.Atoi(s)
+		return goption.Of(i, err == nil)
+	}
+	assert.True(t, goption.Equal(goption.Some(1), goption.FlatMap(goption.Some("1"), atoi)))
+	assert.True(t, goption.FlatMap(goption.Some("a"), atoi).IsNone())
+	assert.True(t, goption.FlatMap(goption.None[string](), atoi).IsNone())
+
+	assert.True(t, goption.Equal(goption.None[int](), goption.None[int]()))
+	assert.False(t, goption.Equal(goption.Some(1), goption.Some(2)))
+	assert.False(t, goption.Equal(goption.Some(0), goption.None[int]()))
+}
+
+func TestJSON(t *testing.T) {
+	type S struct {
+		A goption.Option[int]    `json:"a"`
+		B goption.Option[string] `json:"b"`
+	}
+	bs, err := json.Marshal(S{A: goption.Some(1)})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"a":1,"b":null}`, string(bs))
+
+	var s S
+	assert.Nil(t, json.Unmarshal([]byte(`{"a":null,"b":"x"}`), &s))
+	assert.True(t, s.A.IsNone())
+	assert.Equal(t, "x", s.B.MustGet())
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"a":"x"}`), &s))
+}
@@ -0,0 +1,80 @@
+// Package gpage
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gpage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+// CursorPage is a page of items fetched by cursor.
+type CursorPage[T any] struct {
+	Items []T `json:"items"`
+	// Next is the cursor of the next page, it is empty if there is no next page.
+	Next    string `json:"next"`
+	HasNext bool   `json:"has_next"`
+}
+
+// EncodeCursor encodes k into an opaque cursor which is safe to be used in URLs.
+func EncodeCursor[K any](k K) (string, error) {
+	bs, err := json.Marshal(k)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+// DecodeCursor decodes a cursor made by [EncodeCursor], it returns ErrInvalidCursor if c is malformed.
+func DecodeCursor[K any](c string) (K, error) {
+	var k K
+	bs, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return k, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(bs, &k); err != nil {
+		return k, ErrInvalidCursor
+	}
+	return k, nil
+}
+
+// ByCursor returns at most limit items of s after cursor, an empty cursor starts from the beginning.
+// s must be sorted by key in ascending order and the keys must be unique, the cursor encodes the key of
+// the last item of a page, so the next page is still correct after items are inserted or removed.
+//
+// EXAMPLE:
+//
+//	p, _ := ByCursor(users, func(u User) int64 { return u.ID }, "", 20)
+//	p, _ = ByCursor(users, func(u User) int64 { return u.ID }, p.Next, 20)
+func ByCursor[T any, K constraints.Ordered](s []T, key func(T) K, cursor string, limit int) (CursorPage[T], error) {
+	if limit <= 0 {
+		return CursorPage[T]{}, ErrInvalidPageSize
+	}
+	lo := 0
+	if cursor != "" {
+		after, err := DecodeCursor[K](cursor)
+		if err != nil {
+			return CursorPage[T]{}, err
+		}
+		lo = sort.Search(len(s), func(i int) bool {
+			return key(s[i]) > after
+		})
+	}
+	hi := len(s)
+	if limit < hi-lo {
+		hi = lo + limit
+	}
+	ret := CursorPage[T]{Items: append(make([]T, 0, hi-lo), s[lo:hi]...)}
+	if hi < len(s) {
+		next, err := EncodeCursor(key(s[hi-1]))
+		if err != nil {
+			return CursorPage[T]{}, err
+		}
+		ret.Next, ret.HasNext = next, true
+	}
+	return ret, nil
+}
@@ -0,0 +1,87 @@
+// Package gpage
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gpage
+
+import (
+	"errors"
+)
+
+var (
+	// ErrInvalidOffset is returned when the offset is negative.
+	ErrInvalidOffset = errors.New("gpage: offset must not be negative")
+	// ErrInvalidPageNo is returned when the page number is less than 1.
+	ErrInvalidPageNo = errors.New("gpage: page number must be positive")
+	// ErrInvalidPageSize is returned when the page size or limit is not positive.
+	ErrInvalidPageSize = errors.New("gpage: page size must be positive")
+	// ErrInvalidCursor is returned when a cursor cannot be decoded.
+	ErrInvalidCursor = errors.New("gpage: invalid cursor")
+)
+
+// Page is a page of items with its metadata.
+type Page[T any] struct {
+	// Items is a copy of the items in the page, it is empty if the page is out of range.
+	Items []T `json:"items"`
+	// Total is the number of all items.
+	Total int `json:"total"`
+	// PageNo is the 1-based number of the page.
+	PageNo   int  `json:"page_no"`
+	PageSize int  `json:"page_size"`
+	HasNext  bool `json:"has_next"`
+}
+
+// ByOffset returns the page of s which starts at offset and holds at most limit items.
+// PageNo is the number of the page holding the item at offset.
+//
+// EXAMPLE:
+//
+//	ByOffset([]int{1, 2, 3, 4, 5}, 2, 2) => Page{Items: []int{3, 4}, Total: 5, PageNo: 2, PageSize: 2, HasNext: true}
+//	ByOffset([]int{1, 2, 3}, 5, 2)       => Page{Items: []int{}, Total: 3, PageNo: 3, PageSize: 2, HasNext: false}
+//	ByOffset([]int{1, 2, 3}, -1, 2)      => ErrInvalidOffset
+func ByOffset[T any](s []T, offset, limit int) (Page[T], error) {
+	if offset < 0 {
+		return Page[T]{}, ErrInvalidOffset
+	}
+	if limit <= 0 {
+		return Page[T]{}, ErrInvalidPageSize
+	}
+	return page(s, offset, limit, offset/limit+1), nil
+}
+
+// ByPageNo returns the page numbered pageNo of s, the first page is numbered 1.
+//
+// EXAMPLE:
+//
+//	ByPageNo([]int{1, 2, 3, 4, 5}, 3, 2) => Page{Items: []int{5}, Total: 5, PageNo: 3, PageSize: 2, HasNext: false}
+//	ByPageNo([]int{1, 2, 3}, 0, 2)       => ErrInvalidPageNo
+func ByPageNo[T any](s []T, pageNo, pageSize int) (Page[T], error) {
+	if pageNo < 1 {
+		return Page[T]{}, ErrInvalidPageNo
+	}
+	if pageSize <= 0 {
+		return Page[T]{}, ErrInvalidPageSize
+	}
+	if pageNo-1 > len(s)/pageSize {
+		// out of range, avoid overflowing the offset
+		return Page[T]{Items: []T{}, Total: len(s), PageNo: pageNo, PageSize: pageSize}, nil
+	}
+	return page(s, (pageNo-1)*pageSize, pageSize, pageNo), nil
+}
+
+func page[T any](s []T, offset, limit, pageNo int) Page[T] {
+	lo, hi := len(s), len(s)
+	if offset < len(s) {
+		lo = offset
+		if limit < len(s)-offset {
+			hi = offset + limit
+		}
+	}
+	return Page[T]{
+		Items:    append(make([]T, 0, hi-lo), s[lo:hi]...),
+		Total:    len(s),
+		PageNo:   pageNo,
+		PageSize: limit,
+		HasNext:  hi < len(s),
+	}
+}
@@ -0,0 +1,135 @@
+// Package gpage
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gpage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hyphennn/glambda/gpage"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestByOffset(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	p, err := gpage.ByOffset(s, 2, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, gpage.Page[int]{Items: []int{3, 4}, Total: 5, PageNo: 2, PageSize: 2, HasNext: true}, p)
+
+	p, _ = gpage.ByOffset(s, 3, 5)
+	assert.Equal(t, []int{4, 5}, p.Items)
+	assert.False(t, p.HasNext)
+	assert.Equal(t, 1, p.PageNo)
+
+	p, _ = gpage.ByOffset(s, 9, 2)
+	assert.Equal(t, []int{}, p.Items)
+	assert.Equal(t, 5, p.Total)
+
+	// items do not share memory with s
+	p, _ = gpage.ByOffset(s, 0, 2)
+	p.Items = append(p.Items, 100)
+	assert.Equal(t, 3, s[2])
+
+	_, err = gpage.ByOffset(s, -1, 2)
+	assert.Equal(t, gpage.ErrInvalidOffset, err)
+	_, err = gpage.ByOffset(s, 0, 0)
+	assert.Equal(t, gpage.ErrInvalidPageSize, err)
+}
+
+func TestByPageNo(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	p, err := gpage.ByPageNo(s, 3, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, gpage.Page[int]{Items: []int{5}, Total: 5, PageNo: 3, PageSize: 2}, p)
+
+	p, _ = gpage.ByPageNo(s, 1, 2)
+	assert.Equal(t, []int{1, 2}, p.Items)
+	assert.True(t, p.HasNext)
+
+	p, _ = gpage.ByPageNo(s, 1<<62, 4)
+	assert.Equal(t, []int{}, p.Items)
+	assert.False(t, p.HasNext)
+
+	_, err = gpage.ByPageNo(s, 0, 2)
+	assert.Equal(t, gpage.ErrInvalidPageNo, err)
+	_, err = gpage.ByPageNo(s, 1, -1)
+	assert.Equal(t, gpage.ErrInvalidPageSize, err)
+}
+
+type user struct {
+	ID   int64
+	Name string
+}
+
+func TestByCursor(t *testing.T) {
+	us := []user{{1, "a"}, {3, "b"}, {4, "c"}, {7, "d"}, {9, "e"}}
+	id := func(u user) int64 { return u.ID }
+
+	var got []string
+	cursor := ""
+	for {
+		p, err := gpage.ByCursor(us, id, cursor, 2)
+		assert.Nil(t, err)
+		for _, u := range p.Items {
+			got = append(got, u.Name)
+		}
+		if !p.HasNext {
+			assert.Equal(t, "", p.Next)
+			break
+		}
+		cursor = p.Next
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, got)
+
+	// the cursor still works after the item it points to is removed
+	p, _ := gpage.ByCursor(us, id, "", 2)
+	p, _ = gpage.ByCursor([]user{{1, "a"}, {4, "c"}, {7, "d"}}, id, p.Next, 2)
+	assert.Equal(t, []user{{4, "c"}, {7, "d"}}, p.Items)
+
+	_, err := gpage.ByCursor(us, id, "!!", 2)
+	assert.Equal(t, gpage.ErrInvalidCursor, err)
+	_, err = gpage.ByCursor(us, id, "", 0)
+	assert.Equal(t, gpage.ErrInvalidPageSize, err)
+
+	c, err := gpage.EncodeCursor("k")
+	assert.Nil(t, err)
+	_, err = gpage.DecodeCursor[int](c)
+	assert.Equal(t, gpage.ErrInvalidCursor, err)
+}
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+	it := gpage.NewIterator(ctx, gpage.SliceFetcher([]int{1, 2, 3, 4, 5}, 2))
+	var pages [][]int
+	for it.Next() {
+		pages = append(pages, it.Items())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, pages)
+	assert.False(t, it.Next())
+
+	all, err := gpage.All(ctx, gpage.SliceFetcher([]int{}, 3))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(all))
+
+	boom := errors.New("boom")
+	calls := 0
+	strs, err := gpage.All(ctx, func(ctx context.Context, cursor string) ([]string, string, error) {
+		calls++
+		if cursor == "" {
+			return []string{"a"}, "next", nil
+		}
+		return nil, "", boom
+	})
+	assert.Equal(t, boom, err)
+	assert.Equal(t, []string{"a"}, strs)
+	assert.Equal(t, 2, calls)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = gpage.All(cctx, gpage.SliceFetcher([]int{1}, 1))
+	assert.Equal(t, context.Canceled, err)
+}
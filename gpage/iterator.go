@@ -0,0 +1,111 @@
+// Package gpage
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gpage
+
+import (
+	"context"
+)
+
+// Fetcher fetches the page after cursor, the cursor of the first page is empty.
+// It returns the items of the page and the cursor of the next one, an empty next means it is the last page.
+type Fetcher[T any] func(ctx context.Context, cursor string) (items []T, next string, err error)
+
+// SliceFetcher returns a Fetcher walking s in pages of pageSize items.
+// It panics if pageSize is not positive.
+func SliceFetcher[T any](s []T, pageSize int) Fetcher[T] {
+	if pageSize <= 0 {
+		panic(ErrInvalidPageSize)
+	}
+	return func(_ context.Context, cursor string) ([]T, string, error) {
+		offset := 0
+		if cursor != "" {
+			var err error
+			offset, err = DecodeCursor[int](cursor)
+			if err != nil || offset < 0 {
+				return nil, "", ErrInvalidCursor
+			}
+		}
+		p, err := ByOffset(s, offset, pageSize)
+		if err != nil || !p.HasNext {
+			return p.Items, "", err
+		}
+		return p.Items, encodeOffset(offset + pageSize), nil
+	}
+}
+
+func encodeOffset(offset int) string {
+	// an int never fails to be encoded
+	c, _ := EncodeCursor(offset)
+	return c
+}
+
+// Iterator walks all pages of a Fetcher.
+//
+// EXAMPLE:
+//
+//	it := NewIterator(ctx, fetch)
+//	for it.Next() {
+//		handle(it.Items())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx    context.Context
+	fetch  Fetcher[T]
+	cursor string
+	done   bool
+	items  []T
+	err    error
+}
+
+// NewIterator returns an Iterator positioned before the first page.
+func NewIterator[T any](ctx context.Context, fetch Fetcher[T]) *Iterator[T] {
+	return &Iterator[T]{ctx: ctx, fetch: fetch}
+}
+
+// Next fetches the next page, it returns false when there is no more page or an error occurs.
+func (it *Iterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.items, it.err, it.done = nil, err, true
+		return false
+	}
+	items, next, err := it.fetch(it.ctx, it.cursor)
+	if err != nil {
+		it.items, it.err, it.done = nil, err, true
+		return false
+	}
+	it.items, it.cursor, it.done = items, next, next == ""
+	return true
+}
+
+// Items returns the items of the current page.
+func (it *Iterator[T]) Items() []T {
+	return it.items
+}
+
+// Cursor returns the cursor of the page after the current one, which can be persisted to resume the walk.
+func (it *Iterator[T]) Cursor() string {
+	return it.cursor
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All walks all pages of fetch and returns their items.
+// On error, it returns the items fetched so far and the error.
+func All[T any](ctx context.Context, fetch Fetcher[T]) ([]T, error) {
+	var ret []T
+	it := NewIterator(ctx, fetch)
+	for it.Next() {
+		ret = append(ret, it.Items()...)
+	}
+	return ret, it.Err()
+}
@@ -0,0 +1,213 @@
+// Package gpool
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+
+	"github.com/hyphennn/glambda/gresult"
+	"github.com/hyphennn/glambda/gutils"
+)
+
+var (
+	// ErrPoolClosed is returned when submitting to a pool which is shut down.
+	ErrPoolClosed = errors.New("gpool: pool is closed")
+	// ErrQueueFull is returned by [Pool.TrySubmit] when the queue is full.
+	ErrQueueFull = errors.New("gpool: queue is full")
+)
+
+// PanicError is the error of a task whose function panicked.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("gpool: task panicked: %v", e.Value)
+}
+
+// Pool runs a function over submitted inputs with a fixed number of workers.
+// Submitted inputs wait in a bounded queue, so submitting blocks (or fails with [Pool.TrySubmit])
+// when the workers cannot keep up.
+//
+// The result of every task is kept until [Pool.Wait], so a Pool is meant for a batch of tasks
+// rather than running forever.
+type Pool[In, Out any] struct {
+	fc    func(context.Context, In) (Out, error)
+	queue *gutils.SafeChan[task[In]]
+	wg    sync.WaitGroup
+
+	mu    sync.Mutex
+	slots []slot[Out]
+	// pending counts submits between reserve and done, Wait waits on idle until it drops to zero.
+	pending int
+	idle    *sync.Cond
+}
+
+type task[In any] struct {
+	ctx context.Context
+	in  In
+	idx int
+}
+
+type slot[Out any] struct {
+	r gresult.Result[Out]
+	// skip is true if the task failed to be submitted.
+	skip bool
+}
+
+// New starts a pool with the given number of workers and queue size, which calls fc for each submitted input.
+// A non-positive workers means runtime.GOMAXPROCS(0), and a non-positive queueSize means an unbuffered queue.
+// fc has the same shape as the one of gutils.MustDoCtx.
+//
+// EXAMPLE:
+//
+//	p := New(8, 100, userService.GetUser)
+//	for _, id := range ids {
+//		_ = p.Submit(ctx, id)
+//	}
+//	users, err := gresult.Partition(p.Wait())
+func New[In, Out any](workers, queueSize int, fc func(context.Context, In) (Out, error)) *Pool[In, Out] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+	p := &Pool[In, Out]{
+		fc:    fc,
+		queue: gutils.NewSafeChan[task[In]](queueSize),
+	}
+	p.idle = sync.NewCond(&p.mu)
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+func (p *Pool[In, Out]) work() {
+	defer p.wg.Done()
+	for t, ok := p.queue.Recv(); ok; t, ok = p.queue.Recv() {
+		r := p.run(t)
+		p.mu.Lock()
+		p.slots[t.idx].r = r
+		p.mu.Unlock()
+	}
+}
+
+func (p *Pool[In, Out]) run(t task[In]) (r gresult.Result[Out]) {
+	if err := t.ctx.Err(); err != nil {
+		return gresult.Err[Out](err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			r = gresult.Err[Out](&PanicError{Value: v, Stack: debug.Stack()})
+		}
+	}()
+	return gresult.Of(p.fc(t.ctx, t.in))
+}
+
+// reserve reserves a result slot for a new task, so that results keep the submission order.
+// Every reserve must be followed by a done.
+func (p *Pool[In, Out]) reserve() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pending++
+	p.slots = append(p.slots, slot[Out]{})
+	return len(p.slots) - 1
+}
+
+// done ends the submit of the task at idx, marking its slot as skipped if it was not queued.
+func (p *Pool[In, Out]) done(idx int, queued bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !queued {
+		p.slots[idx].skip = true
+	}
+	p.pending--
+	if p.pending == 0 {
+		p.idle.Broadcast()
+	}
+}
+
+// Submit queues input in, blocking while the queue is full.
+// ctx is passed to the function of the pool when the task runs; if ctx is done before the task
+// starts, the task is skipped and its result is the error of ctx.
+// It returns ErrPoolClosed if the pool is shut down, or the error of ctx if ctx is done before in is queued.
+func (p *Pool[In, Out]) Submit(ctx context.Context, in In) error {
+	idx := p.reserve()
+	err := p.queue.SendCtx(ctx, task[In]{ctx: ctx, in: in, idx: idx})
+	p.done(idx, err == nil)
+	if err != nil {
+		if errors.Is(err, gutils.ErrChanClosed) {
+			return ErrPoolClosed
+		}
+		return err
+	}
+	return nil
+}
+
+// TrySubmit queues input in without blocking, it returns ErrQueueFull if the queue is full
+// and ErrPoolClosed if the pool is shut down.
+func (p *Pool[In, Out]) TrySubmit(ctx context.Context, in In) error {
+	idx := p.reserve()
+	queued := p.queue.TrySend(task[In]{ctx: ctx, in: in, idx: idx})
+	p.done(idx, queued)
+	if !queued {
+		if p.queue.IsClosed() {
+			return ErrPoolClosed
+		}
+		return ErrQueueFull
+	}
+	return nil
+}
+
+// Shutdown stops accepting new tasks and waits for queued and running tasks to finish.
+// If ctx is done first, it returns the error of ctx, the remaining tasks keep running in background.
+// It is safe to call Shutdown more than once.
+func (p *Pool[In, Out]) Shutdown(ctx context.Context) error {
+	p.queue.Close()
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Wait shuts down the pool, waits for all tasks to finish, and returns their results in submission order.
+// Inputs which failed to be submitted have no result, Wait also waits for submits running concurrently
+// to fail or succeed, so that none of them leaves an empty result.
+//
+// EXAMPLE:
+//
+//	vs, err := gresult.Partition(p.Wait())
+func (p *Pool[In, Out]) Wait() []gresult.Result[Out] {
+	_ = p.Shutdown(context.Background())
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// the queue is closed, so pending submits return soon
+	for p.pending > 0 {
+		p.idle.Wait()
+	}
+	ret := make([]gresult.Result[Out], 0, len(p.slots))
+	for _, s := range p.slots {
+		if !s.skip {
+			ret = append(ret, s.r)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,164 @@
+// Package gpool
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gpool_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyphennn/glambda/gpool"
+	"github.com/hyphennn/glambda/gresult"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestPoolOrder(t *testing.T) {
+	var running, peak int32
+	p := gpool.New(4, 8, func(ctx context.Context, i int) (string, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			old := atomic.LoadInt32(&peak)
+			if n <= old || atomic.CompareAndSwapInt32(&peak, old, n) {
+				break
+			}
+		}
+		defer atomic.AddInt32(&running, -1)
+		time.Sleep(time.Millisecond * time.Duration(10-i%10))
+		if i == 7 {
+			return "", errors.New("seven")
+		}
+		return strconv.Itoa(i), nil
+	})
+	for i := 0; i < 20; i++ {
+		assert.Nil(t, p.Submit(context.Background(), i))
+	}
+	rs := p.Wait()
+	assert.Equal(t, 20, len(rs))
+	for i, r := range rs {
+		if i == 7 {
+			assert.True(t, r.IsErr())
+			continue
+		}
+		assert.Equal(t, strconv.Itoa(i), r.Unwrap())
+	}
+	assert.True(t, atomic.LoadInt32(&peak) <= 4)
+
+	_, err := gresult.Partition(rs)
+	assert.NotNil(t, err)
+	assert.Equal(t, gpool.ErrPoolClosed, p.Submit(context.Background(), 1))
+}
+
+func TestPoolBackpressure(t *testing.T) {
+	release := make(chan struct{})
+	p := gpool.New(1, 1, func(ctx context.Context, i int) (int, error) {
+		<-release
+		return i, nil
+	})
+	ctx := context.Background()
+	assert.Nil(t, p.Submit(ctx, 1))
+	// wait for the worker to take the first task
+	for p.TrySubmit(ctx, 2) != nil {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, gpool.ErrQueueFull, p.TrySubmit(ctx, 3))
+
+	tctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, p.Submit(tctx, 4))
+
+	close(release)
+	rs := p.Wait()
+	assert.Equal(t, 2, len(rs))
+	assert.Equal(t, 1, rs[0].Unwrap())
+	assert.Equal(t, 2, rs[1].Unwrap())
+	assert.Equal(t, gpool.ErrPoolClosed, p.TrySubmit(ctx, 5))
+}
+
+func TestPoolTaskContext(t *testing.T) {
+	release := make(chan struct{})
+	p := gpool.New(1, 2, func(ctx context.Context, i int) (int, error) {
+		if i == 0 {
+			<-release
+		}
+		return i, ctx.Err()
+	})
+	cctx, cancel := context.WithCancel(context.Background())
+	assert.Nil(t, p.Submit(context.Background(), 0))
+	assert.Nil(t, p.Submit(cctx, 1))
+	cancel()
+	close(release)
+	rs := p.Wait()
+	assert.Equal(t, 0, rs[0].Unwrap())
+	assert.Equal(t, context.Canceled, rs[1].Err())
+}
+
+func TestPoolPanic(t *testing.T) {
+	p := gpool.New(2, 0, func(ctx context.Context, i int) (int, error) {
+		if i == 1 {
+			panic("boom")
+		}
+		return i, nil
+	})
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, p.Submit(context.Background(), i))
+	}
+	rs := p.Wait()
+	var pe *gpool.PanicError
+	assert.True(t, errors.As(rs[1].Err(), &pe))
+	assert.Equal(t, "boom", pe.Value)
+	assert.Equal(t, 2, rs[2].Unwrap())
+}
+
+func TestPoolShutdown(t *testing.T) {
+	release := make(chan struct{})
+	p := gpool.New(1, 0, func(ctx context.Context, i int) (int, error) {
+		<-release
+		return i, nil
+	})
+	assert.Nil(t, p.Submit(context.Background(), 1))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, p.Shutdown(ctx))
+	close(release)
+	assert.Nil(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 1, p.Wait()[0].Unwrap())
+}
+
+func TestPoolWaitConcurrentSubmit(t *testing.T) {
+	for round := 0; round < 50; round++ {
+		p := gpool.New(2, 0, func(ctx context.Context, i int) (int, error) {
+			return i + 1, nil
+		})
+		var (
+			wg     sync.WaitGroup
+			queued int64
+		)
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 20; i++ {
+					if err := p.Submit(context.Background(), i); err == nil {
+						atomic.AddInt64(&queued, 1)
+					} else {
+						assert.Equal(t, gpool.ErrPoolClosed, err)
+					}
+				}
+			}()
+		}
+		rs := p.Wait()
+		wg.Wait()
+		// 与 Wait 并发且失败的 Submit 不应留下零值结果
+		for _, r := range rs {
+			assert.True(t, r.IsOk())
+			assert.NotEqual(t, 0, r.Unwrap())
+		}
+		assert.Equal(t, int(atomic.LoadInt64(&queued)), len(rs))
+	}
+}
@@ -0,0 +1,217 @@
+// Package gresult
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gresult
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyphennn/glambda/goption"
+	"github.com/hyphennn/glambda/gutils"
+)
+
+// Result holds either a value of type T or an error.
+// The zero value of Result is Ok with the zero value of T.
+type Result[T any] struct {
+	v   T
+	err error
+}
+
+// Ok returns a successful Result holding value v.
+//
+// EXAMPLE:
+//
+//	Ok(1).Get() => (1, nil)
+func Ok[T any](v T) Result[T] {
+	return Result[T]{v: v}
+}
+
+// Err returns a failed Result holding error err.
+//
+// EXAMPLE:
+//
+//	Err[int](io.EOF).Get() => (0, io.EOF)
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Of converts the (T, error) pair returned by most functions to a Result.
+// The value is dropped if err is not nil.
+//
+// EXAMPLE:
+//
+//	Of(strconv.Atoi("1")) => Ok(1)
+//	Of(strconv.Atoi("a")) => Err(*strconv.NumError)
+func Of[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// IsOk returns true if r holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if r holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and error of r as a (T, error) pair.
+func (r Result[T]) Get() (T, error) {
+	return r.v, r.err
+}
+
+// Err returns the error of r, or nil if r is Ok.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value of r, it panics if r holds an error.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("gresult: Unwrap called on Err: %v", r.err))
+	}
+	return r.v
+}
+
+// UnwrapOr returns the value of r, or def if r holds an error.
+//
+// EXAMPLE:
+//
+//	Of(strconv.Atoi("a")).UnwrapOr(-1) => -1
+func (r Result[T]) UnwrapOr(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.v
+}
+
+// UnwrapOrElse returns the value of r, or the result of fc applied to the error of r.
+//
+// EXAMPLE:
+//
+//	Of(strconv.Atoi("a")).UnwrapOrElse(func(err error) int { log.Println(err); return -1 }) => -1
+func (r Result[T]) UnwrapOrElse(fc func(error) T) T {
+	if r.err != nil {
+		return fc(r.err)
+	}
+	return r.v
+}
+
+// Option converts r to a [goption.Option], the error is dropped.
+func (r Result[T]) Option() goption.Option[T] {
+	return goption.Of(r.v, r.err == nil)
+}
+
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.v)
+}
+
+// Map applies function fc to the value of r, an error is returned as is.
+//
+// EXAMPLE:
+//
+//	Map(Ok(1), strconv.Itoa)          => Ok("1")
+//	Map(Err[int](io.EOF), strconv.Itoa) => Err[string](io.EOF)
+func Map[F, T any](r Result[F], fc func(F) T) Result[T] {
+	if r.err != nil {
+		return Err[T](r.err)
+	}
+	return Ok(fc(r.v))
+}
+
+// AndThen applies function fc, which may fail, to the value of r. An error is returned as is.
+//
+// EXAMPLE:
+//
+//	AndThen(Ok("1"), Lift(strconv.Atoi)) => Ok(1)
+//	AndThen(Ok("a"), Lift(strconv.Atoi)) => Err(*strconv.NumError)
+func AndThen[F, T any](r Result[F], fc func(F) Result[T]) Result[T] {
+	if r.err != nil {
+		return Err[T](r.err)
+	}
+	return fc(r.v)
+}
+
+// Lift converts a function returning (T, error) to a function returning Result[T],
+// so that it can be used with [gslice.Map] and friends.
+//
+// EXAMPLE:
+//
+//	gslice.Map([]string{"1", "a"}, Lift(strconv.Atoi)) => []Result[int]{Ok(1), Err(*strconv.NumError)}
+func Lift[F, T any](fc func(F) (T, error)) func(F) Result[T] {
+	return func(f F) Result[T] {
+		return Of(fc(f))
+	}
+}
+
+// Do is the error-preserving variant of [gutils.MustDo].
+//
+// EXAMPLE:
+//
+//	Do("1", strconv.Atoi) => Ok(1)
+func Do[K, V any](key K, fc func(K) (V, error)) Result[V] {
+	return Of(fc(key))
+}
+
+// DoCtx is the error-preserving variant of [gutils.MustDoCtx].
+func DoCtx[K, V any](ctx context.Context, key K, fc func(context.Context, K) (V, error)) Result[V] {
+	return Of(fc(ctx, key))
+}
+
+// EasyDo is the error-preserving variant of [gutils.MustEasyDo].
+func EasyDo[V any](fc func() (V, error)) Result[V] {
+	return Of(fc())
+}
+
+// Partition splits rs into the values of all Ok results and the errors of all Err results.
+// Errors are joined by [gutils.JoinErrors] in the order of rs, nil is returned if there is no error.
+//
+// EXAMPLE:
+//
+//	Partition(gslice.Map([]string{"1", "a", "2"}, Lift(strconv.Atoi))) => ([]int{1, 2}, *strconv.NumError)
+//
+// HINT:
+//
+//   - Use [Collect] if you want all-or-nothing.
+func Partition[T any](rs []Result[T]) ([]T, error) {
+	vs := make([]T, 0, len(rs))
+	var errs []error
+	for _, r := range rs {
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		vs = append(vs, r.v)
+	}
+	return vs, gutils.JoinErrors(errs...)
+}
+
+// Collect returns the values of rs if all of them are Ok, otherwise nil and the first error.
+//
+// EXAMPLE:
+//
+//	Collect([]Result[int]{Ok(1), Ok(2)})         => ([]int{1, 2}, nil)
+//	Collect([]Result[int]{Ok(1), Err[int](err)}) => (nil, err)
+//
+// HINT:
+//
+//   - Use [Partition] if you want to keep the successful values.
+func Collect[T any](rs []Result[T]) ([]T, error) {
+	vs := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.err != nil {
+			return nil, r.err
+		}
+		vs = append(vs, r.v)
+	}
+	return vs, nil
+}
@@ -0,0 +1,87 @@
+// Package gresult
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gresult_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/hyphennn/glambda/gresult"
+	"github.com/hyphennn/glambda/gslice"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestResult(t *testing.T) {
+	ok := gresult.Ok(1)
+	assert.True(t, ok.IsOk())
+	assert.False(t, ok.IsErr())
+	assert.Nil(t, ok.Err())
+	assert.Equal(t, 1, ok.Unwrap())
+	assert.Equal(t, 1, ok.UnwrapOr(2))
+	assert.Equal(t, 1, ok.Option().MustGet())
+	assert.Equal(t, "Ok(1)", ok.String())
+
+	bad := gresult.Err[int](io.EOF)
+	assert.True(t, bad.IsErr())
+	assert.Equal(t, io.EOF, bad.Err())
+	assert.Equal(t, 2, bad.UnwrapOr(2))
+	assert.Equal(t, 3, bad.UnwrapOrElse(func(error) int { return 3 }))
+	assert.True(t, bad.Option().IsNone())
+	assert.Panic(t, func() { bad.Unwrap() })
+	assert.Equal(t, "Err(EOF)", bad.String())
+
+	v, err := gresult.Of(strconv.Atoi("a")).Get()
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, v)
+}
+
+func TestCompose(t *testing.T) {
+	assert.Equal(t, "1", gresult.Map(gresult.Ok(1), strconv.Itoa).Unwrap())
+	assert.Equal(t, io.EOF, gresult.Map(gresult.Err[int](io.EOF), strconv.Itoa).Err())
+
+	atoi := gresult.Lift(strconv.Atoi)
+	assert.Equal(t, 1, gresult.AndThen(gresult.Ok("1"), atoi).Unwrap())
+	assert.True(t, gresult.AndThen(gresult.Ok("a"), atoi).IsErr())
+	assert.Equal(t, io.EOF, gresult.AndThen(gresult.Err[string](io.EOF), atoi).Err())
+}
+
+func TestDo(t *testing.T) {
+	assert.Equal(t, 1, gresult.Do("1", strconv.Atoi).Unwrap())
+	assert.True(t, gresult.DoCtx(context.Background(), "a", func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}).IsErr())
+	assert.Equal(t, io.EOF, gresult.EasyDo(func() (int, error) { return 0, io.EOF }).Err())
+}
+
+func TestPartition(t *testing.T) {
+	rs := gslice.Map([]string{"1", "a", "2", "b"}, gresult.Lift(strconv.Atoi))
+	vs, err := gresult.Partition(rs)
+	assert.Equal(t, []int{1, 2}, vs)
+	assert.NotNil(t, err)
+	var numErr *strconv.NumError
+	assert.True(t, errors.As(err, &numErr))
+	assert.Equal(t, "a", numErr.Num)
+
+	vs, err = gresult.Partition(gslice.Map([]string{"1", "2"}, gresult.Lift(strconv.Atoi)))
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, vs)
+
+	vs, err = gresult.Partition[int](nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{}, vs)
+}
+
+func TestCollect(t *testing.T) {
+	vs, err := gresult.Collect([]gresult.Result[int]{gresult.Ok(1), gresult.Ok(2)})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, vs)
+
+	vs, err = gresult.Collect([]gresult.Result[int]{gresult.Ok(1), gresult.Err[int](io.EOF)})
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, []int(nil), vs)
+}
@@ -0,0 +1,71 @@
+// Package gretry
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gretry
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff returns the delay before the next attempt.
+// attempt is the number of attempts made so far, starting from 1, and prev is the previous delay,
+// which is 0 before the first retry.
+type Backoff func(attempt int, prev time.Duration) time.Duration
+
+// Constant waits d between attempts.
+func Constant(d time.Duration) Backoff {
+	return func(int, time.Duration) time.Duration {
+		return d
+	}
+}
+
+// Exponential waits base, 2*base, 4*base... between attempts, the delay never exceeds max.
+// A non-positive max means no limit.
+//
+// EXAMPLE:
+//
+//	Exponential(100*time.Millisecond, time.Second) => 100ms, 200ms, 400ms, 800ms, 1s, 1s...
+func Exponential(base, max time.Duration) Backoff {
+	return func(attempt int, _ time.Duration) time.Duration {
+		d := base
+		for i := 1; i < attempt; i++ {
+			// stop doubling before overflow or reaching max
+			if d > (1<<63-1)/2 || (max > 0 && d >= max) {
+				break
+			}
+			d *= 2
+		}
+		if max > 0 && d > max {
+			return max
+		}
+		return d
+	}
+}
+
+// DecorrelatedJitter waits a random delay in [base, 3*prev] between attempts, the delay never exceeds max.
+// It spreads retries of concurrent callers better than Exponential.
+// A non-positive max means no limit.
+//
+// See https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/ for details.
+func DecorrelatedJitter(base, max time.Duration) Backoff {
+	return func(_ int, prev time.Duration) time.Duration {
+		if prev < base {
+			prev = base
+		}
+		upper := prev * 3
+		if upper < prev {
+			// overflow
+			upper = 1<<63 - 1
+		}
+		d := base
+		if upper > base {
+			d += time.Duration(rand.Int63n(int64(upper - base)))
+		}
+		if max > 0 && d > max {
+			return max
+		}
+		return d
+	}
+}
@@ -0,0 +1,180 @@
+// Package gretry
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gretry
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyphennn/glambda/gutils"
+)
+
+const (
+	// DefaultMaxAttempts is the number of attempts made if WithMaxAttempts is not given.
+	DefaultMaxAttempts = 3
+	// DefaultBaseDelay and DefaultMaxDelay are the parameters of the default Exponential backoff.
+	DefaultBaseDelay = 100 * time.Millisecond
+	DefaultMaxDelay  = 10 * time.Second
+)
+
+// Attempt describes a finished attempt, it is passed to the hook set by [OnAttempt].
+type Attempt struct {
+	// N is the number of the attempt, starting from 1.
+	N int
+	// Err is the error returned by the attempt, it is nil if the attempt succeeded.
+	Err error
+	// Elapsed is the time since the first attempt started.
+	Elapsed time.Duration
+	// Retry is true if another attempt will be made after Delay.
+	Retry bool
+	Delay time.Duration
+}
+
+type config struct {
+	maxAttempts int
+	maxElapsed  time.Duration
+	backoff     Backoff
+	retryIf     func(error) bool
+	sleep       func(context.Context, time.Duration) error
+	now         func() time.Time
+	onAttempt   func(Attempt)
+}
+
+// Option configures [Retry].
+type Option func(*config)
+
+// WithMaxAttempts limits the number of attempts, including the first one.
+// A non-positive n means no limit, the default is DefaultMaxAttempts.
+func WithMaxAttempts(n int) Option {
+	return func(c *config) {
+		c.maxAttempts = n
+	}
+}
+
+// WithMaxElapsed stops retrying if the next attempt would start more than d after the first one.
+// A non-positive d means no limit, which is the default.
+func WithMaxElapsed(d time.Duration) Option {
+	return func(c *config) {
+		c.maxElapsed = d
+	}
+}
+
+// WithBackoff sets the delay between attempts, the default is Exponential(DefaultBaseDelay, DefaultMaxDelay).
+func WithBackoff(b Backoff) Option {
+	return func(c *config) {
+		c.backoff = b
+	}
+}
+
+// WithRetryIf sets the classifier of retryable errors, by default every error is retried.
+//
+// EXAMPLE:
+//
+//	WithRetryIf(func(err error) bool { return !errors.Is(err, ErrNotFound) })
+func WithRetryIf(fc func(error) bool) Option {
+	return func(c *config) {
+		c.retryIf = fc
+	}
+}
+
+// WithSleeper replaces the function used to wait between attempts.
+// It must return the error of ctx if ctx is done before d elapses.
+// It is intended for tests.
+func WithSleeper(fc func(ctx context.Context, d time.Duration) error) Option {
+	return func(c *config) {
+		c.sleep = fc
+	}
+}
+
+// WithClock replaces the clock used for WithMaxElapsed, which is time.Now by default.
+// It is intended for tests.
+func WithClock(now func() time.Time) Option {
+	return func(c *config) {
+		c.now = now
+	}
+}
+
+// OnAttempt sets a hook called after every attempt, e.g. for logging.
+func OnAttempt(fc func(Attempt)) Option {
+	return func(c *config) {
+		c.onAttempt = fc
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     Exponential(DefaultBaseDelay, DefaultMaxDelay),
+		retryIf:     func(error) bool { return true },
+		sleep:       sleep,
+		now:         time.Now,
+		onAttempt:   func(Attempt) {},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Retry calls fc until it succeeds, returns a non-retryable error, or the limits are reached.
+// It returns the value of the successful attempt, or the error of the last attempt.
+// If ctx is done while waiting between attempts, the error of ctx is joined with the last error.
+//
+// EXAMPLE:
+//
+//	v, err := Retry(ctx, func(ctx context.Context) (*User, error) {
+//		return client.GetUser(ctx, id)
+//	}, WithMaxAttempts(5), WithBackoff(DecorrelatedJitter(50*time.Millisecond, time.Second)))
+func Retry[V any](ctx context.Context, fc func(context.Context) (V, error), opts ...Option) (V, error) {
+	c := newConfig(opts)
+	start := c.now()
+	var delay time.Duration
+	for n := 1; ; n++ {
+		v, err := fc(ctx)
+		a := Attempt{N: n, Err: err, Elapsed: c.now().Sub(start)}
+		if err == nil {
+			c.onAttempt(a)
+			return v, nil
+		}
+		if c.maxAttempts <= 0 || n < c.maxAttempts {
+			a.Delay = c.backoff(n, delay)
+			a.Retry = c.retryIf(err) && ctx.Err() == nil &&
+				(c.maxElapsed <= 0 || a.Elapsed+a.Delay <= c.maxElapsed)
+		}
+		c.onAttempt(a)
+		if !a.Retry {
+			return v, err
+		}
+		delay = a.Delay
+		if serr := c.sleep(ctx, delay); serr != nil {
+			return v, gutils.JoinErrors(serr, err)
+		}
+	}
+}
+
+// Do is the retrying variant of [gutils.MustDo].
+func Do[K, V any](key K, fc func(K) (V, error), opts ...Option) (V, error) {
+	return Retry(context.Background(), func(context.Context) (V, error) {
+		return fc(key)
+	}, opts...)
+}
+
+// DoCtx is the retrying variant of [gutils.MustDoCtx].
+func DoCtx[K, V any](ctx context.Context, key K, fc func(context.Context, K) (V, error), opts ...Option) (V, error) {
+	return Retry(ctx, func(ctx context.Context) (V, error) {
+		return fc(ctx, key)
+	}, opts...)
+}
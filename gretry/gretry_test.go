@@ -0,0 +1,162 @@
+// Package gretry
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gretry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyphennn/glambda/gretry"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+var errTemp = errors.New("temporary")
+
+// fakeSleeper records the delays and advances the fake clock instead of sleeping.
+type fakeSleeper struct {
+	now    time.Time
+	delays []time.Duration
+}
+
+func (f *fakeSleeper) sleep(ctx context.Context, d time.Duration) error {
+	f.delays = append(f.delays, d)
+	f.now = f.now.Add(d)
+	return ctx.Err()
+}
+
+func (f *fakeSleeper) opts() []gretry.Option {
+	return []gretry.Option{
+		gretry.WithSleeper(f.sleep),
+		gretry.WithClock(func() time.Time { return f.now }),
+	}
+}
+
+func failN(n int) func(context.Context) (int, error) {
+	calls := 0
+	return func(context.Context) (int, error) {
+		calls++
+		if calls <= n {
+			return 0, errTemp
+		}
+		return calls, nil
+	}
+}
+
+func TestRetry(t *testing.T) {
+	f := &fakeSleeper{}
+	var as []gretry.Attempt
+	v, err := gretry.Retry(context.Background(), failN(2),
+		append(f.opts(), gretry.OnAttempt(func(a gretry.Attempt) { as = append(as, a) }))...)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, f.delays)
+	assert.Equal(t, 3, len(as))
+	assert.True(t, as[0].Retry)
+	assert.Equal(t, errTemp, as[1].Err)
+	assert.Equal(t, 300*time.Millisecond, as[2].Elapsed)
+	assert.Nil(t, as[2].Err)
+
+	f = &fakeSleeper{}
+	_, err = gretry.Retry(context.Background(), failN(5), f.opts()...)
+	assert.Equal(t, errTemp, err)
+	assert.Equal(t, 2, len(f.delays))
+
+	f = &fakeSleeper{}
+	v, err = gretry.Retry(context.Background(), failN(5), append(f.opts(), gretry.WithMaxAttempts(0))...)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, v)
+}
+
+func TestRetryIf(t *testing.T) {
+	f := &fakeSleeper{}
+	permanent := errors.New("permanent")
+	calls := 0
+	_, err := gretry.Retry(context.Background(), func(context.Context) (int, error) {
+		calls++
+		return 0, permanent
+	}, append(f.opts(), gretry.WithRetryIf(func(err error) bool { return !errors.Is(err, permanent) }))...)
+	assert.Equal(t, permanent, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestMaxElapsed(t *testing.T) {
+	f := &fakeSleeper{}
+	_, err := gretry.Retry(context.Background(), failN(10), append(f.opts(),
+		gretry.WithMaxAttempts(0),
+		gretry.WithBackoff(gretry.Constant(time.Second)),
+		gretry.WithMaxElapsed(3500*time.Millisecond),
+	)...)
+	assert.Equal(t, errTemp, err)
+	assert.Equal(t, 3, len(f.delays))
+}
+
+func TestContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := gretry.Retry(ctx, func(context.Context) (int, error) {
+		return 0, errTemp
+	}, gretry.WithSleeper(func(ctx context.Context, d time.Duration) error {
+		cancel()
+		return ctx.Err()
+	}))
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.True(t, errors.Is(err, errTemp))
+
+	// the real sleeper honors ctx
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = gretry.Retry(ctx, func(context.Context) (int, error) {
+		return 0, errTemp
+	}, gretry.WithBackoff(gretry.Constant(time.Hour)))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestDo(t *testing.T) {
+	f := &fakeSleeper{}
+	calls := 0
+	v, err := gretry.DoCtx(context.Background(), "k", func(ctx context.Context, k string) (string, error) {
+		calls++
+		if calls == 1 {
+			return "", errTemp
+		}
+		return k + k, nil
+	}, f.opts()...)
+	assert.Nil(t, err)
+	assert.Equal(t, "kk", v)
+
+	v2, err := gretry.Do(2, func(i int) (int, error) { return i * 2, nil })
+	assert.Nil(t, err)
+	assert.Equal(t, 4, v2)
+}
+
+func TestBackoff(t *testing.T) {
+	e := gretry.Exponential(time.Millisecond, 10*time.Millisecond)
+	var got []time.Duration
+	for i := 1; i <= 6; i++ {
+		got = append(got, e(i, 0)/time.Millisecond)
+	}
+	assert.Equal(t, []time.Duration{1, 2, 4, 8, 10, 10}, got)
+	assert.Equal(t, time.Duration(1<<62), gretry.Exponential(1, 0)(100, 0))
+
+	assert.Equal(t, time.Second, gretry.Constant(time.Second)(5, 0))
+
+	j := gretry.DecorrelatedJitter(10*time.Millisecond, 100*time.Millisecond)
+	prev := time.Duration(0)
+	for i := 1; i <= 100; i++ {
+		d := j(i, prev)
+		lower, upper := 10*time.Millisecond, 3*prev
+		if upper < lower {
+			upper = 3 * lower
+		}
+		if upper > 100*time.Millisecond {
+			upper = 100 * time.Millisecond
+		}
+		assert.True(t, d >= lower && d <= upper)
+		prev = d
+	}
+}
@@ -0,0 +1,245 @@
+// Package gset
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gset
+
+// Set is an unordered set of comparable elements.
+// The zero value is a nil set which can be read but not written, use [New] to create one.
+type Set[T comparable] map[T]struct{}
+
+// New returns a set containing the given elements.
+//
+// EXAMPLE:
+//
+//	New(1, 2, 2, 3) => Set[int]{1, 2, 3}
+//	New[int]()      => Set[int]{}
+func New[T comparable](vs ...T) Set[T] {
+	s := make(Set[T], len(vs))
+	s.Add(vs...)
+	return s
+}
+
+// FromSlice returns a set containing the elements of all slices in ss.
+//
+// EXAMPLE:
+//
+//	FromSlice([]int{1, 2}, []int{2, 3}) => Set[int]{1, 2, 3}
+//	FromSlice(gslice.Distinct(s))       => a set of s
+func FromSlice[T comparable](ss ...[]T) Set[T] {
+	l := 0
+	for _, s := range ss {
+		l += len(s)
+	}
+	ret := make(Set[T], l)
+	for _, s := range ss {
+		ret.Add(s...)
+	}
+	return ret
+}
+
+// FromMapKeys returns a set containing the keys of map m.
+//
+// EXAMPLE:
+//
+//	FromMapKeys(map[int]string{1: "a", 2: "b"}) => Set[int]{1, 2}
+func FromMapKeys[K comparable, V any](m map[K]V) Set[K] {
+	ret := make(Set[K], len(m))
+	for k := range m {
+		ret[k] = struct{}{}
+	}
+	return ret
+}
+
+// Add adds the given elements to the set.
+func (s Set[T]) Add(vs ...T) {
+	for _, v := range vs {
+		s[v] = struct{}{}
+	}
+}
+
+// Remove removes the given elements from the set.
+func (s Set[T]) Remove(vs ...T) {
+	for _, v := range vs {
+		delete(s, v)
+	}
+}
+
+// Has returns true if v is in the set.
+func (s Set[T]) Has(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// HasAll returns true if all of vs are in the set.
+func (s Set[T]) HasAll(vs ...T) bool {
+	for _, v := range vs {
+		if !s.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny returns true if at least one of vs is in the set.
+func (s Set[T]) HasAny(vs ...T) bool {
+	for _, v := range vs {
+		if s.Has(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of elements in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// ToSlice returns the elements of the set as a slice in unspecified order.
+//
+// EXAMPLE:
+//
+//	New(1, 2).ToSlice() => []int{1, 2} or []int{2, 1}
+func (s Set[T]) ToSlice() []T {
+	ret := make([]T, 0, len(s))
+	for v := range s {
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// Clone returns a copy of the set. Cloning a nil set returns an empty set.
+func (s Set[T]) Clone() Set[T] {
+	ret := make(Set[T], len(s))
+	for v := range s {
+		ret[v] = struct{}{}
+	}
+	return ret
+}
+
+// Range calls fc for each element of the set until fc returns false.
+func (s Set[T]) Range(fc func(T) bool) {
+	for v := range s {
+		if !fc(v) {
+			return
+		}
+	}
+}
+
+// Union returns a new set with the elements of s and all of others.
+//
+// EXAMPLE:
+//
+//	New(1, 2).Union(New(2, 3), New(4)) => Set[int]{1, 2, 3, 4}
+func (s Set[T]) Union(others ...Set[T]) Set[T] {
+	ret := s.Clone()
+	for _, o := range others {
+		for v := range o {
+			ret[v] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// Intersect returns a new set with the elements in both s and all of others.
+//
+// EXAMPLE:
+//
+//	New(1, 2, 3).Intersect(New(2, 3, 4), New(3)) => Set[int]{3}
+func (s Set[T]) Intersect(others ...Set[T]) Set[T] {
+	ret := make(Set[T])
+	for v := range s {
+		in := true
+		for _, o := range others {
+			if !o.Has(v) {
+				in = false
+				break
+			}
+		}
+		if in {
+			ret[v] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// Difference returns a new set with the elements in s but not in any of others.
+//
+// EXAMPLE:
+//
+//	New(1, 2, 3).Difference(New(2), New(3)) => Set[int]{1}
+func (s Set[T]) Difference(others ...Set[T]) Set[T] {
+	ret := make(Set[T])
+	for v := range s {
+		in := false
+		for _, o := range others {
+			if o.Has(v) {
+				in = true
+				break
+			}
+		}
+		if !in {
+			ret[v] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// SymmetricDifference returns a new set with the elements in either s or o but not both.
+//
+// EXAMPLE:
+//
+//	New(1, 2, 3).SymmetricDifference(New(2, 3, 4)) => Set[int]{1, 4}
+func (s Set[T]) SymmetricDifference(o Set[T]) Set[T] {
+	ret := make(Set[T])
+	for v := range s {
+		if !o.Has(v) {
+			ret[v] = struct{}{}
+		}
+	}
+	for v := range o {
+		if !s.Has(v) {
+			ret[v] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// IsSubset returns true if every element of s is in o.
+//
+// EXAMPLE:
+//
+//	New(1, 2).IsSubset(New(1, 2, 3)) => true
+//	New(1, 4).IsSubset(New(1, 2, 3)) => false
+func (s Set[T]) IsSubset(o Set[T]) bool {
+	if len(s) > len(o) {
+		return false
+	}
+	for v := range s {
+		if !o.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every element of o is in s.
+//
+// EXAMPLE:
+//
+//	New(1, 2, 3).IsSuperset(New(1, 2)) => true
+func (s Set[T]) IsSuperset(o Set[T]) bool {
+	return o.IsSubset(s)
+}
+
+// Equal returns true if s and o contain the same elements.
+// A nil set is equal to an empty set.
+//
+// EXAMPLE:
+//
+//	New(1, 2).Equal(New(2, 1)) => true
+//	New(1, 2).Equal(New(1))    => false
+func (s Set[T]) Equal(o Set[T]) bool {
+	return len(s) == len(o) && s.IsSubset(o)
+}
@@ -0,0 +1,135 @@
+// Package gset
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gset_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hyphennn/glambda/gmap"
+	"github.com/hyphennn/glambda/gset"
+	"github.com/hyphennn/glambda/gslice"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func sorted(s gset.Set[int]) []int {
+	ret := s.ToSlice()
+	sort.Ints(ret)
+	return ret
+}
+
+func TestSet(t *testing.T) {
+	s := gset.New(1, 2, 2, 3)
+	assert.Equal(t, 3, s.Len())
+	assert.True(t, s.Has(2))
+	assert.False(t, s.Has(4))
+	assert.True(t, s.HasAll(1, 3))
+	assert.False(t, s.HasAll(1, 4))
+	assert.True(t, s.HasAny(4, 3))
+	assert.False(t, s.HasAny(4, 5))
+
+	s.Add(4)
+	s.Remove(1, 5)
+	assert.Equal(t, []int{2, 3, 4}, sorted(s))
+
+	c := s.Clone()
+	c.Add(5)
+	assert.False(t, s.Has(5))
+
+	var nilSet gset.Set[int]
+	assert.False(t, nilSet.Has(1))
+	assert.Equal(t, 0, nilSet.Len())
+	assert.True(t, nilSet.Equal(gset.New[int]()))
+}
+
+func TestConvert(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, sorted(gset.FromSlice([]int{1, 2}, []int{2, 3})))
+	assert.Equal(t, []int{1, 2}, sorted(gset.FromSlice(gslice.Distinct([]int{1, 2, 2}))))
+	assert.Equal(t, []int{1, 2}, sorted(gset.FromMapKeys(map[int]string{1: "a", 2: "b"})))
+	assert.True(t, gset.FromSlice(gmap.CollectKey(map[int]int{1: 1})).Equal(gset.New(1)))
+}
+
+func TestAlgebra(t *testing.T) {
+	a, b := gset.New(1, 2, 3), gset.New(2, 3, 4)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, sorted(a.Union(b, gset.New(5))))
+	assert.Equal(t, []int{2, 3}, sorted(a.Intersect(b)))
+	assert.Equal(t, []int{3}, sorted(a.Intersect(b, gset.New(3))))
+	assert.Equal(t, []int{1}, sorted(a.Difference(b)))
+	assert.Equal(t, []int{1, 2, 3}, sorted(a.Difference()))
+	assert.Equal(t, []int{1, 4}, sorted(a.SymmetricDifference(b)))
+
+	assert.True(t, gset.New(1, 2).IsSubset(a))
+	assert.False(t, gset.New(1, 4).IsSubset(a))
+	assert.True(t, a.IsSuperset(gset.New(1, 2)))
+	assert.False(t, a.IsSuperset(b))
+	assert.True(t, a.Equal(gset.New(3, 2, 1)))
+	assert.False(t, a.Equal(b))
+}
+
+func TestOrderedSet(t *testing.T) {
+	s := gset.NewOrdered(3, 1, 3, 2)
+	assert.Equal(t, []int{3, 1, 2}, s.ToSlice())
+	assert.Equal(t, 3, s.Len())
+	assert.True(t, s.Has(1))
+
+	s.Add(0, 1)
+	s.Remove(1)
+	assert.Equal(t, []int{3, 2, 0}, s.ToSlice())
+	assert.False(t, s.Has(1))
+	assert.Equal(t, 3, s.Len())
+
+	var got []int
+	s.Range(func(i int) bool {
+		got = append(got, i)
+		return len(got) < 2
+	})
+	assert.Equal(t, []int{3, 2}, got)
+
+	c := s.Clone()
+	c.Add(9)
+	assert.False(t, s.Has(9))
+	assert.True(t, s.Equal(gset.NewOrdered(0, 2, 3)))
+	assert.True(t, s.ToSet().Equal(gset.New(0, 2, 3)))
+}
+
+func TestOrderedSetZeroValue(t *testing.T) {
+	var s gset.OrderedSet[int]
+	assert.Equal(t, 0, s.Len())
+	assert.False(t, s.Has(1))
+	assert.Equal(t, []int{}, s.ToSlice())
+	s.Remove(1)
+	assert.True(t, s.Equal(gset.NewOrdered[int]()))
+	assert.Equal(t, 0, s.Clone().Len())
+	assert.Equal(t, 0, s.ToSet().Len())
+
+	s.Add(2, 1)
+	assert.Equal(t, []int{2, 1}, s.ToSlice())
+}
+
+func TestOrderedSetAlgebra(t *testing.T) {
+	a := gset.NewOrdered(3, 2, 1)
+	b := gset.NewOrdered(4, 2, 3)
+
+	assert.Equal(t, []int{3, 2, 1, 4, 0}, a.Union(b, gset.NewOrdered(0, 1)).ToSlice())
+	assert.Equal(t, []int{3, 2, 1}, a.Union().ToSlice())
+	assert.Equal(t, []int{3, 2}, a.Intersect(b).ToSlice())
+	assert.Equal(t, []int{2}, a.Intersect(b, gset.NewOrdered(2, 1)).ToSlice())
+	assert.Equal(t, []int{1}, a.Difference(b).ToSlice())
+	assert.Equal(t, []int{1, 4}, a.SymmetricDifference(b).ToSlice())
+	assert.Equal(t, []int{4, 1}, b.SymmetricDifference(a).ToSlice())
+
+	assert.True(t, gset.NewOrdered(1, 3).IsSubset(a))
+	assert.False(t, b.IsSubset(a))
+	assert.True(t, a.IsSuperset(gset.NewOrdered(2, 1)))
+	assert.False(t, a.IsSuperset(b))
+
+	// 结果是新集合，不修改 a
+	assert.Equal(t, []int{3, 2, 1}, a.ToSlice())
+
+	var z gset.OrderedSet[int]
+	assert.Equal(t, []int{3, 2, 1}, z.Union(a).ToSlice())
+	assert.True(t, z.IsSubset(a))
+	assert.Equal(t, 0, a.Intersect(&z).Len())
+}
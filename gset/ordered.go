@@ -0,0 +1,181 @@
+// Package gset
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gset
+
+import (
+	"github.com/hyphennn/glambda/gutils"
+)
+
+// OrderedSet is a set which remembers the insertion order of its elements.
+// The zero value is an empty set ready to use.
+type OrderedSet[T comparable] struct {
+	ss *gutils.SliceSet[T, T]
+}
+
+// NewOrdered returns an ordered set containing the given elements.
+//
+// EXAMPLE:
+//
+//	NewOrdered(3, 1, 3, 2).ToSlice() => []int{3, 1, 2}
+func NewOrdered[T comparable](vs ...T) *OrderedSet[T] {
+	s := &OrderedSet[T]{ss: gutils.NewSliceSet[T, T]()}
+	s.Add(vs...)
+	return s
+}
+
+// Add adds the given elements to the set, elements already in the set keep their position.
+func (s *OrderedSet[T]) Add(vs ...T) {
+	if s.ss == nil {
+		s.ss = gutils.NewSliceSet[T, T]()
+	}
+	for _, v := range vs {
+		s.ss.Insert(v, v)
+	}
+}
+
+// Remove removes the given elements from the set.
+func (s *OrderedSet[T]) Remove(vs ...T) {
+	if s.ss == nil {
+		return
+	}
+	for _, v := range vs {
+		s.ss.Delete(v)
+	}
+}
+
+// Has returns true if v is in the set.
+func (s *OrderedSet[T]) Has(v T) bool {
+	if s.ss == nil {
+		return false
+	}
+	_, ok := s.ss.Get(v)
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s *OrderedSet[T]) Len() int {
+	if s.ss == nil {
+		return 0
+	}
+	return s.ss.Len()
+}
+
+// ToSlice returns the elements of the set as a new slice in insertion order.
+func (s *OrderedSet[T]) ToSlice() []T {
+	if s.ss == nil {
+		return []T{}
+	}
+	return s.ss.Keys()
+}
+
+// Range calls fc for each element of the set in insertion order until fc returns false.
+func (s *OrderedSet[T]) Range(fc func(T) bool) {
+	if s.ss == nil {
+		return
+	}
+	s.ss.Range(func(k, _ T) bool {
+		return fc(k)
+	})
+}
+
+// Clone returns a copy of the set.
+func (s *OrderedSet[T]) Clone() *OrderedSet[T] {
+	if s.ss == nil {
+		return NewOrdered[T]()
+	}
+	return &OrderedSet[T]{ss: s.ss.Clone()}
+}
+
+// ToSet returns the elements of the set as an unordered [Set].
+func (s *OrderedSet[T]) ToSet() Set[T] {
+	return FromSlice(s.ToSlice())
+}
+
+// Union returns a new ordered set with the elements of s followed by the new elements of others, in their order.
+//
+// EXAMPLE:
+//
+//	NewOrdered(2, 1).Union(NewOrdered(3, 1), NewOrdered(0)).ToSlice() => []int{2, 1, 3, 0}
+func (s *OrderedSet[T]) Union(others ...*OrderedSet[T]) *OrderedSet[T] {
+	ret := s.Clone()
+	for _, o := range others {
+		o.Range(func(v T) bool {
+			ret.Add(v)
+			return true
+		})
+	}
+	return ret
+}
+
+// Intersect returns a new ordered set with the elements in both s and all of others, in the order of s.
+//
+// EXAMPLE:
+//
+//	NewOrdered(3, 2, 1).Intersect(NewOrdered(1, 2), NewOrdered(2, 1, 0)).ToSlice() => []int{2, 1}
+func (s *OrderedSet[T]) Intersect(others ...*OrderedSet[T]) *OrderedSet[T] {
+	ret := NewOrdered[T]()
+	s.Range(func(v T) bool {
+		for _, o := range others {
+			if !o.Has(v) {
+				return true
+			}
+		}
+		ret.Add(v)
+		return true
+	})
+	return ret
+}
+
+// Difference returns a new ordered set with the elements in s but not in any of others, in the order of s.
+//
+// EXAMPLE:
+//
+//	NewOrdered(3, 2, 1).Difference(NewOrdered(2)).ToSlice() => []int{3, 1}
+func (s *OrderedSet[T]) Difference(others ...*OrderedSet[T]) *OrderedSet[T] {
+	ret := NewOrdered[T]()
+	s.Range(func(v T) bool {
+		for _, o := range others {
+			if o.Has(v) {
+				return true
+			}
+		}
+		ret.Add(v)
+		return true
+	})
+	return ret
+}
+
+// SymmetricDifference returns a new ordered set with the elements in either s or o but not both,
+// the ones of s come first, each part in the order of its set.
+//
+// EXAMPLE:
+//
+//	NewOrdered(3, 2, 1).SymmetricDifference(NewOrdered(4, 2)).ToSlice() => []int{3, 1, 4}
+func (s *OrderedSet[T]) SymmetricDifference(o *OrderedSet[T]) *OrderedSet[T] {
+	return s.Difference(o).Union(o.Difference(s))
+}
+
+// IsSubset returns true if every element of s is in o, regardless of order.
+func (s *OrderedSet[T]) IsSubset(o *OrderedSet[T]) bool {
+	if s.Len() > o.Len() {
+		return false
+	}
+	ok := true
+	s.Range(func(v T) bool {
+		ok = o.Has(v)
+		return ok
+	})
+	return ok
+}
+
+// IsSuperset returns true if every element of o is in s, regardless of order.
+func (s *OrderedSet[T]) IsSuperset(o *OrderedSet[T]) bool {
+	return o.IsSubset(s)
+}
+
+// Equal returns true if s and o contain the same elements, regardless of order.
+func (s *OrderedSet[T]) Equal(o *OrderedSet[T]) bool {
+	return s.Len() == o.Len() && s.IsSubset(o)
+}
@@ -0,0 +1,134 @@
+// Package gslice
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gslice
+
+// Chunk splits slice s into consecutive chunks of n elements, the last chunk may be shorter.
+// The chunks are backed by a copy of s, so modifying them does not affect s.
+// It panics if n is not positive.
+//
+// EXAMPLE:
+//
+//	Chunk([]int{1, 2, 3, 4, 5}, 2) => [][]int{{1, 2}, {3, 4}, {5}}
+//	Chunk([]int{}, 2)              => [][]int{}
+//	Chunk(nil, 2)                  => [][]int{}
+//	Chunk([]int{1}, 0)             => panic
+//
+// HINT:
+//
+//   - Use [Window] if you want overlapping chunks.
+//   - Use [ChunkBy] if you want to split by key instead of size.
+func Chunk[T any](s []T, n int) [][]T {
+	if n <= 0 {
+		panic("gslice: Chunk size must be positive")
+	}
+	ret := make([][]T, 0, (len(s)+n-1)/n)
+	cp := append(make([]T, 0, len(s)), s...)
+	for i := 0; i < len(cp); i += n {
+		j := i + n
+		if j > len(cp) {
+			j = len(cp)
+		}
+		ret = append(ret, cp[i:j:j])
+	}
+	return ret
+}
+
+// Window returns the sliding windows of size elements over slice s, moving step elements each time.
+// Trailing elements that cannot fill a whole window are dropped.
+// The windows are backed by a copy of s, overlapping windows share memory with each other.
+// It panics if size or step is not positive.
+//
+// EXAMPLE:
+//
+//	Window([]int{1, 2, 3, 4, 5}, 3, 1) => [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+//	Window([]int{1, 2, 3, 4, 5}, 2, 2) => [][]int{{1, 2}, {3, 4}}
+//	Window([]int{1, 2}, 3, 1)          => [][]int{}
+//
+// HINT:
+//
+//   - Use [Chunk] if you want to keep the trailing elements.
+func Window[T any](s []T, size, step int) [][]T {
+	if size <= 0 || step <= 0 {
+		panic("gslice: Window size and step must be positive")
+	}
+	if len(s) < size {
+		return [][]T{}
+	}
+	ret := make([][]T, 0, (len(s)-size)/step+1)
+	cp := append(make([]T, 0, len(s)), s...)
+	for i := 0; i+size <= len(cp); i += step {
+		ret = append(ret, cp[i:i+size:i+size])
+	}
+	return ret
+}
+
+// Partition splits slice s into the elements for which fc returns true and those for which it returns false.
+// The order of elements is kept in both slices.
+//
+// EXAMPLE:
+//
+//	Partition([]int{1, 2, 3, 4}, func(i int) bool { return i%2 == 0 }) => ([]int{2, 4}, []int{1, 3})
+//	Partition([]int{}, func(i int) bool { return i%2 == 0 })           => ([]int{}, []int{})
+//
+// HINT:
+//
+//   - Use [Filter] or [Reject] if you only need one side.
+func Partition[T any](s []T, fc func(T) bool) ([]T, []T) {
+	matched := make([]T, 0, len(s)/2)
+	unmatched := make([]T, 0, len(s)/2)
+	for _, v := range s {
+		if fc(v) {
+			matched = append(matched, v)
+		} else {
+			unmatched = append(unmatched, v)
+		}
+	}
+	return matched, unmatched
+}
+
+// SplitAt splits slice s into copies of s[:i] and s[i:].
+// i is clamped into [0, len(s)].
+//
+// EXAMPLE:
+//
+//	SplitAt([]int{1, 2, 3}, 1)  => ([]int{1}, []int{2, 3})
+//	SplitAt([]int{1, 2, 3}, 5)  => ([]int{1, 2, 3}, []int{})
+//	SplitAt([]int{1, 2, 3}, -1) => ([]int{}, []int{1, 2, 3})
+func SplitAt[T any](s []T, i int) ([]T, []T) {
+	if i < 0 {
+		i = 0
+	}
+	if i > len(s) {
+		i = len(s)
+	}
+	cp := append(make([]T, 0, len(s)), s...)
+	return cp[:i:i], cp[i:]
+}
+
+// ChunkBy splits slice s into runs of consecutive elements with equal keys returned by function key.
+//
+// EXAMPLE:
+//
+//	ChunkBy([]int{1, 1, 2, 3, 3, 1}, func(i int) int { return i }) => [][]int{{1, 1}, {2}, {3, 3}, {1}}
+//	ChunkBy([]int{}, func(i int) int { return i })                 => [][]int{}
+//
+// HINT:
+//
+//   - Use [GroupBy] if equal keys should be grouped together regardless of position.
+func ChunkBy[T any, K comparable](s []T, key func(T) K) [][]T {
+	ret := make([][]T, 0)
+	if len(s) == 0 {
+		return ret
+	}
+	cp := append(make([]T, 0, len(s)), s...)
+	start, last := 0, key(cp[0])
+	for i := 1; i < len(cp); i++ {
+		if k := key(cp[i]); k != last {
+			ret = append(ret, cp[start:i:i])
+			start, last = i, k
+		}
+	}
+	return append(ret, cp[start:])
+}
@@ -0,0 +1,88 @@
+// Package gslice
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gslice_test
+
+import (
+	"testing"
+
+	"github.com/hyphennn/glambda/gslice"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestChunk(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	chunks := gslice.Chunk(s, 2)
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, chunks)
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, gslice.Chunk(s, 10))
+
+	// 修改分块不影响原切片，append 也不会覆盖相邻分块
+	chunks[0][0] = 100
+	chunks[0] = append(chunks[0], 200)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s)
+	assert.Equal(t, []int{3, 4}, chunks[1])
+
+	// 测试空切片与 nil 切片
+	assert.Equal(t, [][]int{}, gslice.Chunk([]int{}, 2))
+	assert.Equal(t, [][]int{}, gslice.Chunk[int](nil, 2))
+
+	// 非法大小
+	assert.Panic(t, func() { gslice.Chunk(s, 0) })
+}
+
+func TestWindow(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, gslice.Window(s, 3, 1))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, gslice.Window(s, 2, 2))
+	assert.Equal(t, [][]int{{1}, {4}}, gslice.Window(s, 1, 3))
+	assert.Equal(t, [][]int{}, gslice.Window([]int{1, 2}, 3, 1))
+	assert.Equal(t, [][]int{}, gslice.Window[int](nil, 1, 1))
+	assert.Panic(t, func() { gslice.Window(s, 0, 1) })
+	assert.Panic(t, func() { gslice.Window(s, 1, -1) })
+}
+
+func TestPartition(t *testing.T) {
+	even, odd := gslice.Partition([]int{1, 2, 3, 4, 5}, func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, []int{2, 4}, even)
+	assert.Equal(t, []int{1, 3, 5}, odd)
+
+	even, odd = gslice.Partition(nil, func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, []int{}, even)
+	assert.Equal(t, []int{}, odd)
+}
+
+func TestSplitAt(t *testing.T) {
+	s := []int{1, 2, 3}
+	l, r := gslice.SplitAt(s, 1)
+	assert.Equal(t, []int{1}, l)
+	assert.Equal(t, []int{2, 3}, r)
+
+	l = append(l, 100)
+	assert.Equal(t, []int{1, 2, 3}, s)
+	assert.Equal(t, []int{2, 3}, r)
+
+	l, r = gslice.SplitAt(s, 5)
+	assert.Equal(t, []int{1, 2, 3}, l)
+	assert.Equal(t, []int{}, r)
+
+	l, r = gslice.SplitAt(s, -1)
+	assert.Equal(t, []int{}, l)
+	assert.Equal(t, []int{1, 2, 3}, r)
+
+	l, r = gslice.SplitAt[int](nil, 0)
+	assert.Equal(t, []int{}, l)
+	assert.Equal(t, []int{}, r)
+}
+
+func TestChunkBy(t *testing.T) {
+	assert.Equal(t,
+		[][]int{{1, 1}, {2}, {3, 3}, {1}},
+		gslice.ChunkBy([]int{1, 1, 2, 3, 3, 1}, func(i int) int { return i }),
+	)
+	assert.Equal(t,
+		[][]string{{"a", "ab"}, {"b"}},
+		gslice.ChunkBy([]string{"a", "ab", "b"}, func(s string) byte { return s[0] }),
+	)
+	assert.Equal(t, [][]int{}, gslice.ChunkBy([]int{}, func(i int) int { return i }))
+}
@@ -0,0 +1,62 @@
+// Package gslice
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gslice
+
+import (
+	"github.com/hyphennn/glambda/goption"
+)
+
+// FirstOpt is the [goption.Option] variant of [First].
+//
+// EXAMPLE:
+//
+//	FirstOpt([]int{1, 2, 3}, func(i int) bool { return i%2 == 0 }) => goption.Some(2)
+//	FirstOpt([]int{1, 3, 5}, func(i int) bool { return i%2 == 0 }) => goption.None[int]()
+func FirstOpt[T any, S ~[]T](s S, fc func(T) bool) goption.Option[T] {
+	return goption.Of(First(s, fc))
+}
+
+// LastOpt is the [goption.Option] variant of [Last].
+//
+// EXAMPLE:
+//
+//	LastOpt([]int{1, 2, 3, 4}, func(i int) bool { return i%2 == 1 }) => goption.Some(3)
+//	LastOpt([]int{}, func(i int) bool { return i%2 == 1 })           => goption.None[int]()
+func LastOpt[T any, S ~[]T](s S, fc func(T) bool) goption.Option[T] {
+	return goption.Of(Last(s, fc))
+}
+
+// FindOpt is the [goption.Option] variant of [Find].
+//
+// EXAMPLE:
+//
+//	FindOpt([]int{1, 2, 3}, func(i int) bool { return i > 1 }) => goption.Some(2)
+//	FindOpt([]int{1, 2, 3}, func(i int) bool { return i > 5 }) => goption.None[int]()
+func FindOpt[T any](s []T, f func(T) bool) goption.Option[T] {
+	return goption.Of(Find(s, f))
+}
+
+// FindRevOpt is the [goption.Option] variant of [FindRev].
+//
+// EXAMPLE:
+//
+//	FindRevOpt([]int{1, 2, 3}, func(i int) bool { return i > 1 }) => goption.Some(3)
+//	FindRevOpt([]int{1, 2, 3}, func(i int) bool { return i > 5 }) => goption.None[int]()
+func FindRevOpt[T any](s []T, f func(T) bool) goption.Option[T] {
+	return goption.Of(FindRev(s, f))
+}
+
+// LastEOpt is the [goption.Option] variant of [LastE], it returns None if s is empty.
+//
+// EXAMPLE:
+//
+//	LastEOpt([]int{1, 2, 3}) => goption.Some(3)
+//	LastEOpt([]int{})        => goption.None[int]()
+func LastEOpt[T any](s []T) goption.Option[T] {
+	if len(s) == 0 {
+		return goption.None[T]()
+	}
+	return goption.Some(s[len(s)-1])
+}
@@ -0,0 +1,33 @@
+// Package gslice
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gslice_test
+
+import (
+	"testing"
+
+	"github.com/hyphennn/glambda/goption"
+	"github.com/hyphennn/glambda/gslice"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestOpt(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+	s := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, goption.Some(2), gslice.FirstOpt(s, even))
+	assert.Equal(t, goption.Some(4), gslice.LastOpt(s, even))
+	assert.Equal(t, goption.Some(2), gslice.FindOpt(s, even))
+	assert.Equal(t, goption.Some(4), gslice.FindRevOpt(s, even))
+	assert.Equal(t, goption.Some(5), gslice.LastEOpt(s))
+
+	// 测试空切片
+	assert.True(t, gslice.FirstOpt([]int{}, even).IsNone())
+	assert.True(t, gslice.LastOpt([]int{}, even).IsNone())
+	assert.True(t, gslice.FindOpt([]int{1, 3}, even).IsNone())
+	assert.True(t, gslice.FindRevOpt(nil, even).IsNone())
+	assert.True(t, gslice.LastEOpt([]int(nil)).IsNone())
+
+	// 结果可以继续链式处理
+	assert.Equal(t, 20, goption.Map(gslice.FindOpt(s, even), func(i int) int { return i * 10 }).OrElse(0))
+}
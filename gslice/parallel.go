@@ -0,0 +1,198 @@
+// Package gslice
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gslice
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hyphennn/glambda/gutils"
+)
+
+// parallel calls fc for each index in [0, n) with at most limit goroutines.
+// If failFast is true, no more index is dispatched once fc returns an error.
+// fc receives the inner context, which is canceled once failFast stops dispatching or parallel returns.
+// It returns the error of each index, and the error of ctx if ctx is done before all indexes are processed.
+func parallel(ctx context.Context, n, limit int, failFast bool, fc func(context.Context, int) error) ([]error, error) {
+	errs := make([]error, n)
+	if n == 0 {
+		return errs, nil
+	}
+	if limit <= 0 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+	if limit > n {
+		limit = n
+	}
+
+	inner, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		next int64 = -1
+		done int64
+		wg   sync.WaitGroup
+	)
+	wg.Add(limit)
+	for w := 0; w < limit; w++ {
+		go func() {
+			defer wg.Done()
+			for inner.Err() == nil {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				if err := fc(inner, i); err != nil {
+					errs[i] = err
+					if failFast {
+						cancel()
+					}
+				}
+				atomic.AddInt64(&done, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if int(done) < n && ctx.Err() != nil {
+		return errs, ctx.Err()
+	}
+	return errs, nil
+}
+
+// ParallelMap applies function fc to each element of slice s with at most limit goroutines.
+// fc receives a context derived from ctx, which is canceled when ParallelMap returns.
+// Results of fc are returned as a new slice in the same order as s.
+// If ctx is done before all elements are processed, it returns nil and the error of ctx.
+// A non-positive limit means runtime.GOMAXPROCS(0).
+//
+// EXAMPLE:
+//
+//	itoa := func(_ context.Context, i int) string { return strconv.Itoa(i) }
+//	ParallelMap(ctx, []int{1, 2, 3}, 2, itoa) => ([]string{"1", "2", "3"}, nil)
+//	ParallelMap(ctx, []int{}, 2, itoa)        => ([]string{}, nil)
+//
+// HINT:
+//
+//   - Use [ParallelTryMap] if function fc may fail (return (T, error)).
+//   - Use [Map] if fc is cheap, goroutines are not free. Note that the fc of [Map] takes no context,
+//     so a function written for ParallelMap has to be wrapped, e.g. func(v F) T { return fc(ctx, v) }.
+func ParallelMap[F, T any](ctx context.Context, s []F, limit int, fc func(context.Context, F) T) ([]T, error) {
+	ret := make([]T, len(s))
+	_, err := parallel(ctx, len(s), limit, false, func(ctx context.Context, i int) error {
+		ret[i] = fc(ctx, s[i])
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ParallelTryMap applies function fc to each element of slice s with at most limit goroutines.
+// It stops dispatching new elements once fc returns an error, and cancels the context passed to
+// the running calls of fc, then returns nil and that error.
+// If more than one element failed before stopping, the error of the smallest index is returned.
+// Otherwise, results of fc are returned as a new slice in the same order as s.
+//
+// EXAMPLE:
+//
+//	atoi := func(_ context.Context, s string) (int, error) { return strconv.Atoi(s) }
+//	ParallelTryMap(ctx, []string{"1", "2"}, 2, atoi)  => ([]int{1, 2}, nil)
+//	ParallelTryMap(ctx, []string{"1", "a"}, 2, atoi)  => (nil, error)
+//
+// HINT:
+//
+//   - Use [ParallelTryMapAll] if you want to process all elements and collect all errors.
+func ParallelTryMap[F, T any](ctx context.Context, s []F, limit int, fc func(context.Context, F) (T, error)) ([]T, error) {
+	ret := make([]T, len(s))
+	errs, err := parallel(ctx, len(s), limit, true, func(ctx context.Context, i int) error {
+		t, err := fc(ctx, s[i])
+		ret[i] = t
+		return err
+	})
+	for _, e := range errs {
+		if e != nil {
+			return nil, e
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ParallelTryMapAll applies function fc to each element of slice s with at most limit goroutines.
+// Unlike [ParallelTryMap], it does not stop on errors: all results are returned in the same order as s
+// (failed elements hold whatever fc returned with the error), together with all errors joined by
+// [gutils.JoinErrors] in the order of s.
+//
+// EXAMPLE:
+//
+//	ParallelTryMapAll(ctx, []string{"1", "a", "b"}, 2, atoi) => ([]int{1, 0, 0}, gutils.MultiError{...})
+//
+// HINT:
+//
+//   - Use [ParallelTryMap] if you want to stop on the first error.
+func ParallelTryMapAll[F, T any](ctx context.Context, s []F, limit int, fc func(context.Context, F) (T, error)) ([]T, error) {
+	ret := make([]T, len(s))
+	errs, err := parallel(ctx, len(s), limit, false, func(ctx context.Context, i int) error {
+		t, err := fc(ctx, s[i])
+		ret[i] = t
+		return err
+	})
+	return ret, gutils.JoinErrors(append(errs, err)...)
+}
+
+// ParallelFilter returns a new slice containing only the elements of s for which fc returns true.
+// fc is called with at most limit goroutines, and the order of s is preserved.
+// If ctx is done before all elements are processed, it returns nil and the error of ctx.
+//
+// EXAMPLE:
+//
+//	ParallelFilter(ctx, []int{1, 2, 3, 4}, 2, func(_ context.Context, i int) bool { return i%2 == 0 }) => ([]int{2, 4}, nil)
+//
+// HINT:
+//
+//   - Use [Filter] if fc is cheap. Note that the fc of [Filter] takes no context,
+//     so a function written for ParallelFilter has to be wrapped, e.g. func(v F) bool { return fc(ctx, v) }.
+func ParallelFilter[F any](ctx context.Context, s []F, limit int, fc func(context.Context, F) bool) ([]F, error) {
+	keep := make([]bool, len(s))
+	_, err := parallel(ctx, len(s), limit, false, func(ctx context.Context, i int) error {
+		keep[i] = fc(ctx, s[i])
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]F, 0, len(s)/2)
+	for i, v := range s {
+		if keep[i] {
+			ret = append(ret, v)
+		}
+	}
+	return ret, nil
+}
+
+// ParallelForEach applies function fc to each element of slice s with at most limit goroutines.
+// It returns the error of ctx if ctx is done before all elements are processed.
+//
+// EXAMPLE:
+//
+//	ParallelForEach(ctx, []int{1, 2, 3}, 2, func(_ context.Context, i int) { fmt.Println(i) }) => prints 1, 2, 3 in any order
+//
+// HINT:
+//
+//   - Use [ForEach] if fc is cheap or must be called in order. Note that the fc of [ForEach] takes no context,
+//     so a function written for ParallelForEach has to be wrapped, e.g. func(v T) { fc(ctx, v) }.
+func ParallelForEach[T any](ctx context.Context, s []T, limit int, fc func(context.Context, T)) error {
+	_, err := parallel(ctx, len(s), limit, false, func(ctx context.Context, i int) error {
+		fc(ctx, s[i])
+		return nil
+	})
+	return err
+}
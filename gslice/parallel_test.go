@@ -0,0 +1,135 @@
+// Package gslice
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gslice_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hyphennn/glambda/gslice"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func itoa(_ context.Context, i int) string { return strconv.Itoa(i) }
+
+func atoi(_ context.Context, s string) (int, error) { return strconv.Atoi(s) }
+
+func TestParallelMap(t *testing.T) {
+	ctx := context.Background()
+	s := make([]int, 100)
+	for i := range s {
+		s[i] = i
+	}
+	var running, peak int64
+	ret, err := gslice.ParallelMap(ctx, s, 4, func(_ context.Context, i int) string {
+		n := atomic.AddInt64(&running, 1)
+		for {
+			p := atomic.LoadInt64(&peak)
+			if n <= p || atomic.CompareAndSwapInt64(&peak, p, n) {
+				break
+			}
+		}
+		defer atomic.AddInt64(&running, -1)
+		return strconv.Itoa(i)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, gslice.Map(s, strconv.Itoa), ret)
+	assert.True(t, atomic.LoadInt64(&peak) <= 4)
+
+	// 测试空切片
+	ret, err = gslice.ParallelMap(ctx, []int{}, 4, itoa)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, ret)
+
+	// 测试 nil 切片与默认并发度
+	ret, err = gslice.ParallelMap(ctx, nil, 0, itoa)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, ret)
+
+	// 测试取消
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	ret, err = gslice.ParallelMap(canceled, []int{1, 2, 3}, 1, itoa)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, []string(nil), ret)
+}
+
+func TestParallelTryMap(t *testing.T) {
+	ctx := context.Background()
+	ret, err := gslice.ParallelTryMap(ctx, []string{"1", "1", "4", "5", "1", "4"}, 3, atoi)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 1, 4, 5, 1, 4}, ret)
+
+	var called int64
+	ret, err = gslice.ParallelTryMap(ctx, []string{"a", "1", "4", "5", "1", "4"}, 1, func(_ context.Context, s string) (int, error) {
+		atomic.AddInt64(&called, 1)
+		return strconv.Atoi(s)
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, []int(nil), ret)
+	// 只有一个 worker，遇到第一个错误后不再派发
+	assert.Equal(t, int64(1), called)
+}
+
+func TestParallelTryMapAll(t *testing.T) {
+	ctx := context.Background()
+	ret, err := gslice.ParallelTryMapAll(ctx, []string{"1", "a", "3", "b"}, 2, atoi)
+	assert.Equal(t, []int{1, 0, 3, 0}, ret)
+	assert.NotNil(t, err)
+	var numErr *strconv.NumError
+	assert.True(t, errors.As(err, &numErr))
+	assert.Equal(t, "a", numErr.Num)
+
+	ret, err = gslice.ParallelTryMapAll(ctx, []string{"1", "2"}, 2, atoi)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, ret)
+}
+
+func TestParallelFilter(t *testing.T) {
+	ret, err := gslice.ParallelFilter(context.Background(), []int{1, 2, 3, 4, 5, 6}, 2, func(_ context.Context, i int) bool {
+		return i%2 == 0
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 4, 6}, ret)
+}
+
+func TestParallelForEach(t *testing.T) {
+	var sum int64
+	err := gslice.ParallelForEach(context.Background(), []int64{1, 2, 3, 4}, 2, func(_ context.Context, i int64) {
+		atomic.AddInt64(&sum, i)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), sum)
+}
+
+func TestParallelContext(t *testing.T) {
+	// fc 收到的 ctx 在第一个错误后被取消
+	var canceled int64
+	started := make(chan struct{})
+	_, err := gslice.ParallelTryMap(context.Background(), []int{0, 1}, 2, func(ctx context.Context, i int) (int, error) {
+		if i == 0 {
+			<-started
+			return 0, errors.New("boom")
+		}
+		close(started)
+		<-ctx.Done()
+		atomic.AddInt64(&canceled, 1)
+		return i, nil
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(1), canceled)
+
+	// fc 收到的 ctx 继承调用方的值
+	type key struct{}
+	parent := context.WithValue(context.Background(), key{}, "v")
+	ret, err := gslice.ParallelMap(parent, []int{1, 2}, 2, func(ctx context.Context, _ int) any {
+		return ctx.Value(key{})
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []any{"v", "v"}, ret)
+}
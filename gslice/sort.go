@@ -0,0 +1,238 @@
+// Package gslice
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gslice
+
+import (
+	"sort"
+
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+// Sort sorts slice s in ascending order in place.
+//
+// EXAMPLE:
+//
+//	s := []int{3, 1, 2}
+//	Sort(s) => s = []int{1, 2, 3}
+//
+// HINT:
+//
+//   - Use [Sorted] if you don't want to modify s.
+func Sort[T constraints.Ordered](s []T) {
+	sort.Slice(s, func(i, j int) bool {
+		return s[i] < s[j]
+	})
+}
+
+// SortFunc sorts slice s in place by the comparison function less.
+// The sort is not guaranteed to be stable.
+//
+// EXAMPLE:
+//
+//	s := []int{1, 3, 2}
+//	SortFunc(s, func(a, b int) bool { return a > b }) => s = []int{3, 2, 1}
+//
+// HINT:
+//
+//   - Use [By], [ThenBy] and [Reverse] to build less.
+//   - Use [StableSortFunc] if equal elements must keep their order.
+func SortFunc[T any](s []T, less func(T, T) bool) {
+	sort.Slice(s, func(i, j int) bool {
+		return less(s[i], s[j])
+	})
+}
+
+// SortBy sorts slice s in place in ascending order of the key returned by function key.
+// The sort is not guaranteed to be stable.
+//
+// EXAMPLE:
+//
+//	s := []string{"ccc", "a", "bb"}
+//	SortBy(s, func(s string) int { return len(s) }) => s = []string{"a", "bb", "ccc"}
+//
+// HINT:
+//
+//   - Use [StableSortBy] if equal elements must keep their order.
+func SortBy[T any, K constraints.Ordered](s []T, key func(T) K) {
+	SortFunc(s, By(key))
+}
+
+// StableSortFunc sorts slice s in place by the comparison function less, keeping the order of equal elements.
+//
+// EXAMPLE:
+//
+//	s := []string{"b", "a", "c"}
+//	StableSortFunc(s, func(a, b string) bool { return false }) => s = []string{"b", "a", "c"}
+func StableSortFunc[T any](s []T, less func(T, T) bool) {
+	sort.SliceStable(s, func(i, j int) bool {
+		return less(s[i], s[j])
+	})
+}
+
+// StableSortBy sorts slice s in place in ascending order of the key returned by function key,
+// keeping the order of elements with equal keys.
+//
+// EXAMPLE:
+//
+//	s := []string{"bb", "a", "aa"}
+//	StableSortBy(s, func(s string) int { return len(s) }) => s = []string{"a", "bb", "aa"}
+func StableSortBy[T any, K constraints.Ordered](s []T, key func(T) K) {
+	StableSortFunc(s, By(key))
+}
+
+// Sorted returns a sorted copy of slice s in ascending order.
+//
+// EXAMPLE:
+//
+//	Sorted([]int{3, 1, 2}) => []int{1, 2, 3}
+//	Sorted([]int{})        => []int{}
+//	Sorted(nil)            => []int{}
+//
+// HINT:
+//
+//   - Use [Sort] if you want to sort in place.
+func Sorted[T constraints.Ordered](s []T) []T {
+	ret := append(make([]T, 0, len(s)), s...)
+	Sort(ret)
+	return ret
+}
+
+// SortedFunc returns a copy of slice s sorted by the comparison function less.
+//
+// EXAMPLE:
+//
+//	SortedFunc([]int{1, 3, 2}, func(a, b int) bool { return a > b }) => []int{3, 2, 1}
+func SortedFunc[T any](s []T, less func(T, T) bool) []T {
+	ret := append(make([]T, 0, len(s)), s...)
+	SortFunc(ret, less)
+	return ret
+}
+
+// SortedBy returns a copy of slice s stably sorted in ascending order of the key returned by function key.
+//
+// EXAMPLE:
+//
+//	SortedBy([]string{"ccc", "a", "bb"}, func(s string) int { return len(s) }) => []string{"a", "bb", "ccc"}
+func SortedBy[T any, K constraints.Ordered](s []T, key func(T) K) []T {
+	ret := append(make([]T, 0, len(s)), s...)
+	StableSortBy(ret, key)
+	return ret
+}
+
+// IsSorted returns true if slice s is sorted in ascending order.
+//
+// EXAMPLE:
+//
+//	IsSorted([]int{1, 2, 2, 3}) => true
+//	IsSorted([]int{2, 1})       => false
+//	IsSorted([]int{})           => true
+func IsSorted[T constraints.Ordered](s []T) bool {
+	for i := 1; i < len(s); i++ {
+		if s[i] < s[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSortedFunc returns true if slice s is sorted by the comparison function less.
+//
+// EXAMPLE:
+//
+//	IsSortedFunc([]int{3, 2, 1}, func(a, b int) bool { return a > b }) => true
+func IsSortedFunc[T any](s []T, less func(T, T) bool) bool {
+	for i := 1; i < len(s); i++ {
+		if less(s[i], s[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// By returns a comparison function which compares elements by the key returned by function key.
+//
+// EXAMPLE:
+//
+//	type User struct{ Name string; Age int }
+//	SortFunc(users, By(func(u User) int { return u.Age }))
+func By[T any, K constraints.Ordered](key func(T) K) func(T, T) bool {
+	return func(a, b T) bool {
+		return key(a) < key(b)
+	}
+}
+
+// ThenBy returns a comparison function which compares elements by less,
+// and breaks ties by each of next in order.
+//
+// EXAMPLE:
+//
+//	SortFunc(users, ThenBy(
+//		By(func(u User) int { return u.Age }),
+//		By(func(u User) string { return u.Name }),
+//	)) => sorted by age, then by name
+func ThenBy[T any](less func(T, T) bool, next ...func(T, T) bool) func(T, T) bool {
+	return func(a, b T) bool {
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+		for _, l := range next {
+			if l(a, b) {
+				return true
+			}
+			if l(b, a) {
+				return false
+			}
+		}
+		return false
+	}
+}
+
+// Reverse returns a comparison function which reverses the order of less.
+//
+// EXAMPLE:
+//
+//	SortFunc(users, Reverse(By(func(u User) int { return u.Age }))) => sorted by age descending
+func Reverse[T any](less func(T, T) bool) func(T, T) bool {
+	return func(a, b T) bool {
+		return less(b, a)
+	}
+}
+
+// NullsFirst returns a comparison function over pointers, which puts nil before any non-nil pointer
+// and compares non-nil pointers by the pointed values with less.
+//
+// EXAMPLE:
+//
+//	SortFunc([]*int{gconv.ToPtr(2), nil, gconv.ToPtr(1)}, NullsFirst(func(a, b int) bool { return a < b })) => [nil, 1, 2]
+//
+// HINT:
+//
+//   - Use [NullsLast] if nil should be put after non-nil pointers.
+func NullsFirst[T any](less func(T, T) bool) func(*T, *T) bool {
+	return func(a, b *T) bool {
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return less(*a, *b)
+	}
+}
+
+// NullsLast returns a comparison function over pointers, which puts nil after any non-nil pointer
+// and compares non-nil pointers by the pointed values with less.
+//
+// EXAMPLE:
+//
+//	SortFunc([]*int{nil, gconv.ToPtr(2), gconv.ToPtr(1)}, NullsLast(func(a, b int) bool { return a < b })) => [1, 2, nil]
+func NullsLast[T any](less func(T, T) bool) func(*T, *T) bool {
+	return func(a, b *T) bool {
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return less(*a, *b)
+	}
+}
@@ -0,0 +1,82 @@
+// Package gslice
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gslice_test
+
+import (
+	"testing"
+
+	"github.com/hyphennn/glambda/gconv"
+	"github.com/hyphennn/glambda/gslice"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+type user struct {
+	Name string
+	Age  int
+}
+
+func TestSort(t *testing.T) {
+	s := []int{3, 1, 2}
+	gslice.Sort(s)
+	assert.Equal(t, []int{1, 2, 3}, s)
+
+	s = []int{1, 3, 2}
+	gslice.SortFunc(s, func(a, b int) bool { return a > b })
+	assert.Equal(t, []int{3, 2, 1}, s)
+
+	ss := []string{"ccc", "a", "bb"}
+	gslice.SortBy(ss, func(s string) int { return len(s) })
+	assert.Equal(t, []string{"a", "bb", "ccc"}, ss)
+
+	// 稳定排序保持相等元素的原有顺序
+	ss = []string{"bb", "a", "aa", "b"}
+	gslice.StableSortBy(ss, func(s string) int { return len(s) })
+	assert.Equal(t, []string{"a", "b", "bb", "aa"}, ss)
+}
+
+func TestSorted(t *testing.T) {
+	s := []int{3, 1, 2}
+	assert.Equal(t, []int{1, 2, 3}, gslice.Sorted(s))
+	assert.Equal(t, []int{3, 1, 2}, s)
+	assert.Equal(t, []int{3, 2, 1}, gslice.SortedFunc(s, func(a, b int) bool { return a > b }))
+	assert.Equal(t, []int{3, 1, 2}, s)
+	assert.Equal(t, []string{"a", "bb", "ccc"}, gslice.SortedBy([]string{"ccc", "a", "bb"}, func(s string) int { return len(s) }))
+
+	// 测试空切片与 nil 切片
+	assert.Equal(t, []int{}, gslice.Sorted([]int{}))
+	assert.Equal(t, []int{}, gslice.Sorted[int](nil))
+}
+
+func TestIsSorted(t *testing.T) {
+	assert.True(t, gslice.IsSorted([]int{1, 2, 2, 3}))
+	assert.False(t, gslice.IsSorted([]int{2, 1}))
+	assert.True(t, gslice.IsSorted([]int{}))
+	assert.True(t, gslice.IsSortedFunc([]int{3, 2, 1}, func(a, b int) bool { return a > b }))
+	assert.False(t, gslice.IsSortedFunc([]int{1, 2}, func(a, b int) bool { return a > b }))
+}
+
+func TestComparator(t *testing.T) {
+	users := []user{{"bob", 20}, {"alice", 30}, {"carl", 20}, {"alice", 20}}
+	byAge := gslice.By(func(u user) int { return u.Age })
+	byName := gslice.By(func(u user) string { return u.Name })
+
+	gslice.SortFunc(users, gslice.ThenBy(byAge, byName))
+	assert.Equal(t, []user{{"alice", 20}, {"bob", 20}, {"carl", 20}, {"alice", 30}}, users)
+
+	gslice.SortFunc(users, gslice.ThenBy(gslice.Reverse(byAge), gslice.Reverse(byName)))
+	assert.Equal(t, []user{{"alice", 30}, {"carl", 20}, {"bob", 20}, {"alice", 20}}, users)
+
+	less := func(a, b int) bool { return a < b }
+	ps := []*int{gconv.ToPtr(2), nil, gconv.ToPtr(1), nil}
+	gslice.SortFunc(ps, gslice.NullsFirst(less))
+	assert.True(t, ps[0] == nil && ps[1] == nil)
+	assert.Equal(t, 1, *ps[2])
+	assert.Equal(t, 2, *ps[3])
+
+	gslice.SortFunc(ps, gslice.NullsLast(less))
+	assert.Equal(t, 1, *ps[0])
+	assert.Equal(t, 2, *ps[1])
+	assert.True(t, ps[2] == nil && ps[3] == nil)
+}
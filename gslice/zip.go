@@ -0,0 +1,157 @@
+// Package gslice
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gslice
+
+import (
+	"github.com/hyphennn/glambda/gutils"
+)
+
+// Zip pairs up the elements of slices a and b by index.
+// The result is as long as the shorter one of a and b.
+//
+// EXAMPLE:
+//
+//	Zip([]int{1, 2, 3}, []string{"a", "b"}) => []gutils.Pair[int, string]{{1, "a"}, {2, "b"}}
+//	Zip([]int{}, []string{"a"})             => []gutils.Pair[int, string]{}
+//
+// HINT:
+//
+//   - Use [ZipLongest] if you want to keep the extra elements of the longer slice.
+//   - Use [ZipWith] if you want to combine the elements with a function.
+func Zip[A, B any](a []A, b []B) []gutils.Pair[A, B] {
+	return ZipWith(a, b, func(x A, y B) gutils.Pair[A, B] {
+		return gutils.Pair[A, B]{First: x, Second: y}
+	})
+}
+
+// ZipWith applies function fc to the elements of slices a and b with the same index.
+// The result is as long as the shorter one of a and b.
+//
+// EXAMPLE:
+//
+//	ZipWith([]int{1, 2}, []int{10, 20}, func(a, b int) int { return a + b }) => []int{11, 22}
+func ZipWith[A, B, T any](a []A, b []B, fc func(A, B) T) []T {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	ret := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		ret = append(ret, fc(a[i], b[i]))
+	}
+	return ret
+}
+
+// ZipLongest pairs up the elements of slices a and b by index.
+// The result is as long as the longer one of a and b, missing elements are filled with fillA or fillB.
+//
+// EXAMPLE:
+//
+//	ZipLongest([]int{1, 2, 3}, []string{"a"}, 0, "-") => []gutils.Pair[int, string]{{1, "a"}, {2, "-"}, {3, "-"}}
+func ZipLongest[A, B any](a []A, b []B, fillA A, fillB B) []gutils.Pair[A, B] {
+	n := len(a)
+	if len(b) > n {
+		n = len(b)
+	}
+	ret := make([]gutils.Pair[A, B], 0, n)
+	for i := 0; i < n; i++ {
+		p := gutils.Pair[A, B]{First: fillA, Second: fillB}
+		if i < len(a) {
+			p.First = a[i]
+		}
+		if i < len(b) {
+			p.Second = b[i]
+		}
+		ret = append(ret, p)
+	}
+	return ret
+}
+
+// Unzip splits a slice of pairs into a slice of the first elements and a slice of the second elements.
+//
+// EXAMPLE:
+//
+//	Unzip([]gutils.Pair[int, string]{{1, "a"}, {2, "b"}}) => ([]int{1, 2}, []string{"a", "b"})
+//	Unzip([]gutils.Pair[int, string]{})                   => ([]int{}, []string{})
+func Unzip[A, B any](ps []gutils.Pair[A, B]) ([]A, []B) {
+	as := make([]A, 0, len(ps))
+	bs := make([]B, 0, len(ps))
+	for _, p := range ps {
+		as = append(as, p.First)
+		bs = append(bs, p.Second)
+	}
+	return as, bs
+}
+
+// Enumerate pairs up each element of slice s with its index.
+//
+// EXAMPLE:
+//
+//	Enumerate([]string{"a", "b"}) => []gutils.Pair[int, string]{{0, "a"}, {1, "b"}}
+//
+// HINT:
+//
+//   - Use [ForEachIdx] if you only want to iterate with the index.
+func Enumerate[T any](s []T) []gutils.Pair[int, T] {
+	ret := make([]gutils.Pair[int, T], 0, len(s))
+	for i, v := range s {
+		ret = append(ret, gutils.Pair[int, T]{First: i, Second: v})
+	}
+	return ret
+}
+
+// Product returns the cartesian product of slices a and b, ordered by a first.
+//
+// EXAMPLE:
+//
+//	Product([]int{1, 2}, []string{"a", "b"}) => []gutils.Pair[int, string]{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}
+//	Product([]int{1, 2}, []string{})         => []gutils.Pair[int, string]{}
+func Product[A, B any](a []A, b []B) []gutils.Pair[A, B] {
+	ret := make([]gutils.Pair[A, B], 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			ret = append(ret, gutils.Pair[A, B]{First: x, Second: y})
+		}
+	}
+	return ret
+}
+
+// Zip3 groups the elements of slices a, b and c by index.
+// The result is as long as the shortest one of a, b and c.
+//
+// EXAMPLE:
+//
+//	Zip3([]int{1, 2}, []string{"a", "b"}, []bool{true}) => []gutils.Tuple3[int, string, bool]{{1, "a", true}}
+func Zip3[A, B, C any](a []A, b []B, c []C) []gutils.Tuple3[A, B, C] {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	if len(c) < n {
+		n = len(c)
+	}
+	ret := make([]gutils.Tuple3[A, B, C], 0, n)
+	for i := 0; i < n; i++ {
+		ret = append(ret, gutils.MakeTuple3(a[i], b[i], c[i]))
+	}
+	return ret
+}
+
+// Unzip3 splits a slice of triples into three slices of their elements.
+//
+// EXAMPLE:
+//
+//	Unzip3([]gutils.Tuple3[int, string, bool]{{1, "a", true}}) => ([]int{1}, []string{"a"}, []bool{true})
+func Unzip3[A, B, C any](ts []gutils.Tuple3[A, B, C]) ([]A, []B, []C) {
+	as := make([]A, 0, len(ts))
+	bs := make([]B, 0, len(ts))
+	cs := make([]C, 0, len(ts))
+	for _, t := range ts {
+		as = append(as, t.First)
+		bs = append(bs, t.Second)
+		cs = append(cs, t.Third)
+	}
+	return as, bs, cs
+}
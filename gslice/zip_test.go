@@ -0,0 +1,79 @@
+// Package gslice
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gslice_test
+
+import (
+	"testing"
+
+	"github.com/hyphennn/glambda/gslice"
+	"github.com/hyphennn/glambda/gutils"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+type intStr = gutils.Pair[int, string]
+
+func TestZip(t *testing.T) {
+	assert.Equal(t,
+		[]intStr{{First: 1, Second: "a"}, {First: 2, Second: "b"}},
+		gslice.Zip([]int{1, 2, 3}, []string{"a", "b"}),
+	)
+	assert.Equal(t, []intStr{}, gslice.Zip([]int{}, []string{"a"}))
+	assert.Equal(t, []intStr{}, gslice.Zip[int, string](nil, nil))
+
+	assert.Equal(t, []int{11, 22}, gslice.ZipWith([]int{1, 2}, []int{10, 20, 30}, func(a, b int) int { return a + b }))
+}
+
+func TestZipLongest(t *testing.T) {
+	assert.Equal(t,
+		[]intStr{{First: 1, Second: "a"}, {First: 2, Second: "-"}, {First: 3, Second: "-"}},
+		gslice.ZipLongest([]int{1, 2, 3}, []string{"a"}, 0, "-"),
+	)
+	assert.Equal(t,
+		[]intStr{{First: 1, Second: "a"}, {First: -1, Second: "b"}},
+		gslice.ZipLongest([]int{1}, []string{"a", "b"}, -1, "-"),
+	)
+}
+
+func TestUnzip(t *testing.T) {
+	as, bs := gslice.Unzip(gslice.Zip([]int{1, 2}, []string{"a", "b"}))
+	assert.Equal(t, []int{1, 2}, as)
+	assert.Equal(t, []string{"a", "b"}, bs)
+
+	as, bs = gslice.Unzip[int, string](nil)
+	assert.Equal(t, []int{}, as)
+	assert.Equal(t, []string{}, bs)
+}
+
+func TestEnumerate(t *testing.T) {
+	assert.Equal(t,
+		[]gutils.Pair[int, string]{{First: 0, Second: "a"}, {First: 1, Second: "b"}},
+		gslice.Enumerate([]string{"a", "b"}),
+	)
+	assert.Equal(t, []gutils.Pair[int, string]{}, gslice.Enumerate[string](nil))
+}
+
+func TestProduct(t *testing.T) {
+	assert.Equal(t,
+		[]intStr{{First: 1, Second: "a"}, {First: 1, Second: "b"}, {First: 2, Second: "a"}, {First: 2, Second: "b"}},
+		gslice.Product([]int{1, 2}, []string{"a", "b"}),
+	)
+	assert.Equal(t, []intStr{}, gslice.Product([]int{1, 2}, []string{}))
+}
+
+func TestZip3(t *testing.T) {
+	ts := gslice.Zip3([]int{1, 2, 3}, []string{"a", "b"}, []bool{true, false, true})
+	assert.Equal(t, []gutils.Tuple3[int, string, bool]{
+		gutils.MakeTuple3(1, "a", true),
+		gutils.MakeTuple3(2, "b", false),
+	}, ts)
+
+	as, bs, cs := gslice.Unzip3(ts)
+	assert.Equal(t, []int{1, 2}, as)
+	assert.Equal(t, []string{"a", "b"}, bs)
+	assert.Equal(t, []bool{true, false}, cs)
+
+	as, _, _ = gslice.Unzip3(gslice.Zip3([]int{}, []string{"a"}, []bool{true}))
+	assert.Equal(t, []int{}, as)
+}
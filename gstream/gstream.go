@@ -0,0 +1,399 @@
+// Package gstream
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gstream
+
+import (
+	"sort"
+
+	"github.com/hyphennn/glambda/gmap"
+	"github.com/hyphennn/glambda/gvalue"
+)
+
+// Stream is a lazy, pull-based sequence of elements with type T.
+// Intermediate operations such as [Stream.Filter] or [Map] only wrap the
+// upstream pull function, nothing is evaluated until a terminal operation
+// such as [Stream.Collect] is called.
+//
+// A Stream can only be consumed once.
+type Stream[T any] struct {
+	next func() (T, bool)
+}
+
+// Generate returns a stream that pulls elements from fc until fc returns false.
+//
+// EXAMPLE:
+//
+//	i := 0
+//	Generate(func() (int, bool) { i++; return i, i <= 3 }).Collect() => []int{1, 2, 3}
+//
+// HINT:
+//
+//   - Combine with [Stream.Take] to consume an infinite generator.
+func Generate[T any](fc func() (T, bool)) Stream[T] {
+	done := false
+	return Stream[T]{next: func() (T, bool) {
+		if done {
+			return gvalue.Zero[T](), false
+		}
+		t, ok := fc()
+		if !ok {
+			done = true
+			return gvalue.Zero[T](), false
+		}
+		return t, true
+	}}
+}
+
+// FromSlice returns a stream over the elements of slice s.
+// The slice is not copied, modifications to s before the terminal operation are visible.
+//
+// EXAMPLE:
+//
+//	FromSlice([]int{1, 2, 3}).Collect() => []int{1, 2, 3}
+//	FromSlice([]int(nil)).Collect()     => []int{}
+func FromSlice[T any](s []T) Stream[T] {
+	i := 0
+	return Stream[T]{next: func() (T, bool) {
+		if i >= len(s) {
+			return gvalue.Zero[T](), false
+		}
+		i++
+		return s[i-1], true
+	}}
+}
+
+// Of returns a stream over the given elements.
+//
+// EXAMPLE:
+//
+//	Of(1, 2, 3).Collect() => []int{1, 2, 3}
+func Of[T any](s ...T) Stream[T] {
+	return FromSlice(s)
+}
+
+// FromMap returns a stream that applies function fc to each key and value of map m.
+// The keys of m are snapshotted when FromMap is called, values are read lazily.
+//
+// EXAMPLE:
+//
+//	FromMap(map[int]string{1: "a"}, gmap.UseValue[int, string]).Collect() => []string{"a"}
+//
+// HINT:
+//
+//   - Use [gmap.UseKey], [gmap.UseValue] or [gmap.UsePair] as fc, just like [gmap.ToSlice].
+func FromMap[K comparable, V, T any](m map[K]V, fc gmap.KVTrans[K, V, T]) Stream[T] {
+	ks := gmap.CollectKey(m)
+	i := 0
+	return Stream[T]{next: func() (T, bool) {
+		for i < len(ks) {
+			k := ks[i]
+			i++
+			// the key may be deleted after snapshot
+			if v, ok := m[k]; ok {
+				return fc(k, v), true
+			}
+		}
+		return gvalue.Zero[T](), false
+	}}
+}
+
+// FromChan returns a stream that receives elements from channel ch until it is closed.
+//
+// EXAMPLE:
+//
+//	ch := make(chan int, 2)
+//	ch <- 1; ch <- 2; close(ch)
+//	FromChan(ch).Collect() => []int{1, 2}
+func FromChan[T any](ch <-chan T) Stream[T] {
+	return Stream[T]{next: func() (T, bool) {
+		t, ok := <-ch
+		return t, ok
+	}}
+}
+
+// Next pulls the next element of the stream.
+// It returns the zero value of T and false when the stream is exhausted.
+func (s Stream[T]) Next() (T, bool) {
+	if s.next == nil {
+		return gvalue.Zero[T](), false
+	}
+	return s.next()
+}
+
+// Filter returns a stream containing only the elements for which fc returns true.
+//
+// EXAMPLE:
+//
+//	Of(1, 2, 3, 4).Filter(func(i int) bool { return i%2 == 0 }).Collect() => []int{2, 4}
+func (s Stream[T]) Filter(fc func(T) bool) Stream[T] {
+	return Stream[T]{next: func() (T, bool) {
+		for {
+			t, ok := s.Next()
+			if !ok {
+				return t, false
+			}
+			if fc(t) {
+				return t, true
+			}
+		}
+	}}
+}
+
+// Take returns a stream containing at most the first n elements.
+//
+// EXAMPLE:
+//
+//	Of(1, 2, 3).Take(2).Collect() => []int{1, 2}
+//	Of(1, 2, 3).Take(0).Collect() => []int{}
+func (s Stream[T]) Take(n int) Stream[T] {
+	return Stream[T]{next: func() (T, bool) {
+		if n <= 0 {
+			return gvalue.Zero[T](), false
+		}
+		n--
+		return s.Next()
+	}}
+}
+
+// Skip returns a stream that discards the first n elements.
+//
+// EXAMPLE:
+//
+//	Of(1, 2, 3).Skip(2).Collect() => []int{3}
+//	Of(1, 2, 3).Skip(5).Collect() => []int{}
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return Stream[T]{next: func() (T, bool) {
+		for ; n > 0; n-- {
+			if _, ok := s.Next(); !ok {
+				return gvalue.Zero[T](), false
+			}
+		}
+		return s.Next()
+	}}
+}
+
+// Peek returns a stream that calls fc on each element as it is pulled.
+//
+// EXAMPLE:
+//
+//	Of(1, 2).Peek(func(i int) { fmt.Println(i) }).Collect() => prints 1, 2
+func (s Stream[T]) Peek(fc func(T)) Stream[T] {
+	return Stream[T]{next: func() (T, bool) {
+		t, ok := s.Next()
+		if ok {
+			fc(t)
+		}
+		return t, ok
+	}}
+}
+
+// Sorted returns a stream whose elements are sorted by the comparison function less.
+// Sorted is a barrier: the whole upstream is buffered when the first element is pulled.
+//
+// EXAMPLE:
+//
+//	Of(3, 1, 2).Sorted(func(a, b int) bool { return a < b }).Collect() => []int{1, 2, 3}
+func (s Stream[T]) Sorted(less func(T, T) bool) Stream[T] {
+	var buf []T
+	loaded := false
+	return Stream[T]{next: func() (T, bool) {
+		if !loaded {
+			loaded = true
+			buf = s.Collect()
+			sort.SliceStable(buf, func(i, j int) bool {
+				return less(buf[i], buf[j])
+			})
+		}
+		if len(buf) == 0 {
+			return gvalue.Zero[T](), false
+		}
+		t := buf[0]
+		buf = buf[1:]
+		return t, true
+	}}
+}
+
+// Collect pulls all elements of the stream into a new slice.
+//
+// EXAMPLE:
+//
+//	Of(1, 2, 3).Collect() => []int{1, 2, 3}
+func (s Stream[T]) Collect() []T {
+	ret := make([]T, 0)
+	for t, ok := s.Next(); ok; t, ok = s.Next() {
+		ret = append(ret, t)
+	}
+	return ret
+}
+
+// ForEach applies function fc to each element of the stream.
+//
+// EXAMPLE:
+//
+//	Of(1, 2, 3).ForEach(func(i int) { fmt.Println(i) }) => prints 1, 2, 3
+func (s Stream[T]) ForEach(fc func(T)) {
+	for t, ok := s.Next(); ok; t, ok = s.Next() {
+		fc(t)
+	}
+}
+
+// Reduce reduces the stream to a single value by applying function fc to each element.
+// The first element is used as the initial value, an empty stream returns the zero value of T.
+//
+// EXAMPLE:
+//
+//	Of(1, 2, 3, 4).Reduce(func(a, b int) int { return a + b }) => 10
+//
+// HINT:
+//
+//   - Use [Fold] if you want to specify an initial value.
+func (s Stream[T]) Reduce(fc func(T, T) T) T {
+	ret, ok := s.Next()
+	if !ok {
+		return ret
+	}
+	for t, ok := s.Next(); ok; t, ok = s.Next() {
+		ret = fc(ret, t)
+	}
+	return ret
+}
+
+// Count pulls all elements of the stream and returns the number of them.
+//
+// EXAMPLE:
+//
+//	Of(1, 2, 3).Count() => 3
+func (s Stream[T]) Count() int {
+	n := 0
+	for _, ok := s.Next(); ok; _, ok = s.Next() {
+		n++
+	}
+	return n
+}
+
+// First returns the first element of the stream.
+// If the stream is empty, it returns the zero value of T and false.
+//
+// EXAMPLE:
+//
+//	Of(1, 2, 3).First() => (1, true)
+//	Of[int]().First()   => (0, false)
+func (s Stream[T]) First() (T, bool) {
+	return s.Next()
+}
+
+// Map returns a stream that applies function fc to each element of stream s.
+//
+// EXAMPLE:
+//
+//	Map(Of(1, 2, 3), strconv.Itoa).Collect() => []string{"1", "2", "3"}
+func Map[F, T any](s Stream[F], fc func(F) T) Stream[T] {
+	return Stream[T]{next: func() (T, bool) {
+		f, ok := s.Next()
+		if !ok {
+			return gvalue.Zero[T](), false
+		}
+		return fc(f), true
+	}}
+}
+
+// FlatMap returns a stream that applies function fc to each element of stream s
+// and flattens the returned slices.
+//
+// EXAMPLE:
+//
+//	FlatMap(Of(1, 2), func(i int) []int { return []int{i, i * 10} }).Collect() => []int{1, 10, 2, 20}
+func FlatMap[F, T any](s Stream[F], fc func(F) []T) Stream[T] {
+	var cur []T
+	return Stream[T]{next: func() (T, bool) {
+		for len(cur) == 0 {
+			f, ok := s.Next()
+			if !ok {
+				return gvalue.Zero[T](), false
+			}
+			cur = fc(f)
+		}
+		t := cur[0]
+		cur = cur[1:]
+		return t, true
+	}}
+}
+
+// Distinct returns a stream with duplicate elements removed, keeping the first occurrence.
+//
+// EXAMPLE:
+//
+//	Distinct(Of(1, 2, 2, 3, 1)).Collect() => []int{1, 2, 3}
+//
+// HINT:
+//
+//   - Use [DistinctBy] if you want to remove duplicates based on a custom key.
+func Distinct[T comparable](s Stream[T]) Stream[T] {
+	return DistinctBy(s, func(t T) T { return t })
+}
+
+// DistinctBy returns a stream with duplicate elements removed based on the key returned by fc.
+//
+// EXAMPLE:
+//
+//	DistinctBy(Of("apple", "banana", "apricot"), func(s string) byte { return s[0] }).Collect()
+//	=> []string{"apple", "banana"}
+func DistinctBy[T any, K comparable](s Stream[T], fc func(T) K) Stream[T] {
+	seen := make(map[K]struct{})
+	return s.Filter(func(t T) bool {
+		k := fc(t)
+		if _, ok := seen[k]; ok {
+			return false
+		}
+		seen[k] = struct{}{}
+		return true
+	})
+}
+
+// Fold reduces the stream to a single value by applying function fc to each element.
+// The initial value is provided as init.
+//
+// EXAMPLE:
+//
+//	Fold(Of(1, 2, 3, 4), func(a, b int) int { return a + b }, 10) => 20
+func Fold[T1, T2 any](s Stream[T1], fc func(T2, T1) T2, init T2) T2 {
+	ret := init
+	for t, ok := s.Next(); ok; t, ok = s.Next() {
+		ret = fc(ret, t)
+	}
+	return ret
+}
+
+// GroupBy groups elements of stream s by the key returned by function fc.
+//
+// EXAMPLE:
+//
+//	GroupBy(Of(1, 2, 3, 4), func(i int) int { return i % 2 }) => map[int][]int{0: {2, 4}, 1: {1, 3}}
+func GroupBy[K comparable, T any](s Stream[T], fc func(T) K) map[K][]T {
+	m := make(map[K][]T)
+	for t, ok := s.Next(); ok; t, ok = s.Next() {
+		k := fc(t)
+		m[k] = append(m[k], t)
+	}
+	return m
+}
+
+// ToMap applies function fc to each element of stream s and collects the results into a map.
+//
+// EXAMPLE:
+//
+//	ToMap(Of(1, 2), func(i int) (string, int) { return strconv.Itoa(i), i * i }) => map[string]int{"1": 1, "2": 4}
+//
+// HINT:
+//
+//   - Ensure that keys returned by fc are unique to avoid overwriting values.
+func ToMap[T, V any, K comparable](s Stream[T], fc func(T) (K, V)) map[K]V {
+	m := make(map[K]V)
+	for t, ok := s.Next(); ok; t, ok = s.Next() {
+		k, v := fc(t)
+		m[k] = v
+	}
+	return m
+}
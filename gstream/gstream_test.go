@@ -0,0 +1,125 @@
+// Package gstream
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gstream_test
+
+import (
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/hyphennn/glambda/gmap"
+	"github.com/hyphennn/glambda/gstream"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestFromSlice(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, gstream.FromSlice([]int{1, 2, 3}).Collect())
+	assert.Equal(t, []int{}, gstream.FromSlice([]int(nil)).Collect())
+	assert.Equal(t, []int{}, gstream.Stream[int]{}.Collect())
+}
+
+func TestFromMap(t *testing.T) {
+	ret := gstream.FromMap(map[int]string{1: "a", 2: "b"}, gmap.UseValue[int, string]).Collect()
+	sort.Strings(ret)
+	assert.Equal(t, []string{"a", "b"}, ret)
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	assert.Equal(t, []int{1, 2, 3}, gstream.FromChan(ch).Collect())
+}
+
+func TestGenerate(t *testing.T) {
+	i := 0
+	s := gstream.Generate(func() (int, bool) {
+		i++
+		return i, true
+	})
+	assert.Equal(t, []int{1, 2, 3}, s.Take(3).Collect())
+
+	j := 0
+	s = gstream.Generate(func() (int, bool) {
+		j++
+		return j, j <= 2
+	})
+	assert.Equal(t, []int{1, 2}, s.Collect())
+}
+
+func TestLazy(t *testing.T) {
+	pulled := 0
+	s := gstream.Of(1, 2, 3, 4, 5, 6).
+		Peek(func(int) { pulled++ }).
+		Filter(func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, 0, pulled)
+	assert.Equal(t, []string{"2"}, gstream.Map(s, strconv.Itoa).Take(1).Collect())
+	assert.Equal(t, 2, pulled)
+}
+
+func TestPipeline(t *testing.T) {
+	assert.Equal(t,
+		[]string{"4", "6", "8"},
+		gstream.Map(
+			gstream.Of(1, 2, 3, 4, 5, 6, 7, 8).Filter(func(i int) bool { return i%2 == 0 }).Skip(1),
+			strconv.Itoa,
+		).Collect(),
+	)
+	assert.Equal(t, []int{}, gstream.Of(1, 2).Skip(5).Collect())
+	assert.Equal(t, []int{}, gstream.Of(1, 2).Take(0).Collect())
+}
+
+func TestFlatMap(t *testing.T) {
+	assert.Equal(t,
+		[]int{1, 10, 3, 30},
+		gstream.FlatMap(gstream.Of(1, 2, 3), func(i int) []int {
+			if i == 2 {
+				return nil
+			}
+			return []int{i, i * 10}
+		}).Collect(),
+	)
+}
+
+func TestDistinct(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, gstream.Distinct(gstream.Of(1, 2, 2, 3, 1)).Collect())
+	assert.Equal(t,
+		[]string{"apple", "banana"},
+		gstream.DistinctBy(gstream.Of("apple", "banana", "apricot"), func(s string) byte { return s[0] }).Collect(),
+	)
+}
+
+func TestSorted(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, gstream.Of(3, 1, 2).Sorted(func(a, b int) bool { return a < b }).Collect())
+	assert.Equal(t, []int{}, gstream.Of[int]().Sorted(func(a, b int) bool { return a < b }).Collect())
+}
+
+func TestTerminal(t *testing.T) {
+	assert.Equal(t, 10, gstream.Of(1, 2, 3, 4).Reduce(func(a, b int) int { return a + b }))
+	assert.Equal(t, 0, gstream.Of[int]().Reduce(func(a, b int) int { return a + b }))
+	assert.Equal(t, 20, gstream.Fold(gstream.Of(1, 2, 3, 4), func(a, b int) int { return a + b }, 10))
+	assert.Equal(t, 3, gstream.Of(1, 2, 3).Count())
+
+	first, ok := gstream.Of(1, 2, 3).First()
+	assert.True(t, ok)
+	assert.Equal(t, 1, first)
+	_, ok = gstream.Of[int]().First()
+	assert.False(t, ok)
+
+	assert.Equal(t,
+		map[int][]int{0: {2, 4}, 1: {1, 3}},
+		gstream.GroupBy(gstream.Of(1, 2, 3, 4), func(i int) int { return i % 2 }),
+	)
+	assert.Equal(t,
+		map[string]int{"1": 1, "2": 4},
+		gstream.ToMap(gstream.Of(1, 2), func(i int) (string, int) { return strconv.Itoa(i), i * i }),
+	)
+
+	sum := 0
+	gstream.Of(1, 2, 3).ForEach(func(i int) { sum += i })
+	assert.Equal(t, 6, sum)
+}
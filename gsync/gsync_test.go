@@ -0,0 +1,174 @@
+// Package gsync
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gsync_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyphennn/glambda/gsync"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestGroupDo(t *testing.T) {
+	var g gsync.Group[int, string]
+	var calls int32
+	release := make(chan struct{})
+	fc := func(i int) (string, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return strconv.Itoa(i), nil
+	}
+
+	const n = 10
+	var wg sync.WaitGroup
+	var nShared int32
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			v, err, shared := g.Do(1, fc)
+			assert.Nil(t, err)
+			assert.Equal(t, "1", v)
+			if shared {
+				atomic.AddInt32(&nShared, 1)
+			}
+		}()
+	}
+	// wait for the first call to start, then give the others time to join it
+	for atomic.LoadInt32(&calls) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, int32(n), atomic.LoadInt32(&nShared))
+
+	// a finished call is not reused
+	v, _, shared := g.Do(1, fc)
+	assert.Equal(t, "1", v)
+	assert.False(t, shared)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestGroupDoChanAndForget(t *testing.T) {
+	var g gsync.Group[string, int]
+	release := make(chan struct{})
+	var calls int32
+	fc := func(k string) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return len(k), errors.New(k)
+	}
+	ch1 := g.DoChan("ab", fc)
+	ch2 := g.DoChan("ab", fc)
+	for atomic.LoadInt32(&calls) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	g.Forget("ab")
+	ch3 := g.DoChan("ab", fc)
+	close(release)
+
+	r1, r2, r3 := <-ch1, <-ch2, <-ch3
+	assert.Equal(t, 2, r1.Val)
+	assert.Equal(t, "ab", r1.Err.Error())
+	assert.True(t, r1.Shared)
+	assert.Equal(t, r1, r2)
+	assert.False(t, r3.Shared)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestGroupPanic(t *testing.T) {
+	var g gsync.Group[int, int]
+	_, err, _ := g.Do(1, func(int) (int, error) { panic("boom") })
+	var pe *gsync.PanicError
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, "boom", pe.Value)
+
+	v, err, _ := g.Do(1, func(i int) (int, error) { return i, nil })
+	assert.Nil(t, err)
+	assert.Equal(t, 1, v)
+}
+
+func TestMemoize(t *testing.T) {
+	calls := map[int]int{}
+	square := gsync.Memoize(func(i int) int {
+		calls[i]++
+		return i * i
+	}, gsync.WithSize(2))
+	assert.Equal(t, 9, square(3))
+	assert.Equal(t, 9, square(3))
+	assert.Equal(t, 16, square(4))
+	assert.Equal(t, 25, square(5))
+	assert.Equal(t, 9, square(3))
+	assert.Equal(t, map[int]int{3: 2, 4: 1, 5: 1}, calls)
+
+	boom := gsync.Memoize(func(int) int { panic("boom") })
+	defer func() {
+		assert.Equal(t, "boom", recover())
+	}()
+	boom(1)
+}
+
+func TestMemoizeErr(t *testing.T) {
+	now := time.Unix(0, 0)
+	calls := 0
+	atoi := gsync.MemoizeErr(func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	}, gsync.WithTTL(time.Second), gsync.WithClock(func() time.Time { return now }))
+
+	v, err := atoi("1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, v)
+	_, _ = atoi("1")
+	assert.Equal(t, 1, calls)
+
+	// errors are not cached
+	_, err = atoi("a")
+	assert.NotNil(t, err)
+	_, err = atoi("a")
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, calls)
+
+	now = now.Add(time.Second)
+	_, _ = atoi("1")
+	assert.Equal(t, 4, calls)
+}
+
+func TestMemoizeCtx(t *testing.T) {
+	var calls int32
+	get := gsync.MemoizeCtx(func(ctx context.Context, k string) (string, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		return k + "!", ctx.Err()
+	})
+	var wg sync.WaitGroup
+	wg.Add(8)
+	for i := 0; i < 8; i++ {
+		go func() {
+			defer wg.Done()
+			v, err := get(context.Background(), "k")
+			assert.Nil(t, err)
+			assert.Equal(t, "k!", v)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := get(ctx, "x")
+	assert.Equal(t, context.Canceled, err)
+	v, err := get(context.Background(), "x")
+	assert.Nil(t, err)
+	assert.Equal(t, "x!", v)
+}
@@ -0,0 +1,110 @@
+// Package gsync
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gsync
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyphennn/glambda/gcache"
+)
+
+type memoConfig struct {
+	size int
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+// MemoOption configures [Memoize], [MemoizeErr] and [MemoizeCtx].
+type MemoOption func(*memoConfig)
+
+// WithSize bounds the number of memoized results, the least recently used one is dropped when it is full.
+// A non-positive n means unbounded, which is the default.
+func WithSize(n int) MemoOption {
+	return func(c *memoConfig) {
+		c.size = n
+	}
+}
+
+// WithTTL makes memoized results expire d after they are computed.
+// A non-positive d means they never expire, which is the default.
+func WithTTL(d time.Duration) MemoOption {
+	return func(c *memoConfig) {
+		c.ttl = d
+	}
+}
+
+// WithClock replaces the clock used for WithTTL, which is time.Now by default.
+// It is intended for tests.
+func WithClock(now func() time.Time) MemoOption {
+	return func(c *memoConfig) {
+		c.now = now
+	}
+}
+
+func newMemoCache[K comparable, V any](opts []MemoOption) *gcache.ExpiringCache[K, V] {
+	c := &memoConfig{now: time.Now}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return gcache.NewExpiringCache[K, V](c.size, c.ttl).WithClock(c.now)
+}
+
+// Memoize returns a function which caches the results of fc by key.
+// Concurrent calls with the same uncached key call fc only once.
+// The returned function is safe for concurrent use.
+//
+// EXAMPLE:
+//
+//	square := Memoize(func(i int) int { return i * i }, WithSize(100))
+//	square(3) => 9, computed
+//	square(3) => 9, cached
+func Memoize[K comparable, V any](fc func(K) V, opts ...MemoOption) func(K) V {
+	memo := MemoizeErr(func(k K) (V, error) {
+		return fc(k), nil
+	}, opts...)
+	return func(k K) V {
+		v, err := memo(k)
+		if pe, ok := err.(*PanicError); ok {
+			// fc never fails, so err must come from a panic of fc
+			panic(pe.Value)
+		}
+		return v
+	}
+}
+
+// MemoizeErr is the variant of [Memoize] for functions which may fail, such as the ones passed to gutils.MustDo.
+// Errors are not cached, so a failed key is computed again on the next call.
+func MemoizeErr[K comparable, V any](fc func(K) (V, error), opts ...MemoOption) func(K) (V, error) {
+	memo := MemoizeCtx(func(_ context.Context, k K) (V, error) {
+		return fc(k)
+	}, opts...)
+	return func(k K) (V, error) {
+		return memo(context.Background(), k)
+	}
+}
+
+// MemoizeCtx is the context-aware variant of [MemoizeErr], for functions such as the ones passed to gutils.MustDoCtx.
+// Concurrent callers of the same key share the call made with the context of the first one.
+func MemoizeCtx[K comparable, V any](fc func(context.Context, K) (V, error), opts ...MemoOption) func(context.Context, K) (V, error) {
+	cache := newMemoCache[K, V](opts)
+	var g Group[K, V]
+	return func(ctx context.Context, k K) (V, error) {
+		if v, ok := cache.Get(k); ok {
+			return v, nil
+		}
+		v, err, _ := g.Do(k, func(k K) (V, error) {
+			if v, ok := cache.Get(k); ok {
+				return v, nil
+			}
+			v, err := fc(ctx, k)
+			if err == nil {
+				cache.Set(k, v)
+			}
+			return v, err
+		})
+		return v, err
+	}
+}
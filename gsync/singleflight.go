@@ -0,0 +1,120 @@
+// Package gsync
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gsync
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// PanicError is the error returned to all callers of a call whose function panicked.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("gsync: call panicked: %v", e.Value)
+}
+
+// Result is the result of [Group.DoChan].
+type Result[V any] struct {
+	Val    V
+	Err    error
+	Shared bool
+}
+
+type call[V any] struct {
+	wg    sync.WaitGroup
+	val   V
+	err   error
+	dups  int
+	chans []chan<- Result[V]
+}
+
+// Group deduplicates concurrent calls with the same key, only one of them runs and the others share its result.
+// The zero value of Group is ready to use.
+//
+// EXAMPLE:
+//
+//	var g Group[int64, *User]
+//	u, err, _ := g.Do(id, userService.GetUser)
+type Group[K comparable, V any] struct {
+	mu sync.Mutex
+	m  map[K]*call[V]
+}
+
+// Do calls fc with key, if a call with the same key is in flight, it waits for that call and returns its result instead.
+// shared is true if the result was given to more than one caller.
+// If fc panics, every caller gets a *PanicError.
+func (g *Group[K, V]) Do(key K, fc func(K) (V, error)) (v V, err error, shared bool) {
+	g.mu.Lock()
+	if g.m == nil {
+		g.m = make(map[K]*call[V])
+	}
+	if c, ok := g.m[key]; ok {
+		c.dups++
+		g.mu.Unlock()
+		c.wg.Wait()
+		return c.val, c.err, true
+	}
+	c := new(call[V])
+	c.wg.Add(1)
+	g.m[key] = c
+	g.mu.Unlock()
+
+	g.doCall(c, key, fc)
+	return c.val, c.err, c.dups > 0
+}
+
+// DoChan is like Do but returns a channel which receives the result once it is ready.
+// The channel is buffered, so the caller may leave without receiving.
+func (g *Group[K, V]) DoChan(key K, fc func(K) (V, error)) <-chan Result[V] {
+	ch := make(chan Result[V], 1)
+	g.mu.Lock()
+	if g.m == nil {
+		g.m = make(map[K]*call[V])
+	}
+	if c, ok := g.m[key]; ok {
+		c.dups++
+		c.chans = append(c.chans, ch)
+		g.mu.Unlock()
+		return ch
+	}
+	c := &call[V]{chans: []chan<- Result[V]{ch}}
+	c.wg.Add(1)
+	g.m[key] = c
+	g.mu.Unlock()
+
+	go g.doCall(c, key, fc)
+	return ch
+}
+
+// Forget makes the next call with key run fc instead of waiting for the call in flight.
+func (g *Group[K, V]) Forget(key K) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.m, key)
+}
+
+func (g *Group[K, V]) doCall(c *call[V], key K, fc func(K) (V, error)) {
+	defer func() {
+		if v := recover(); v != nil {
+			c.err = &PanicError{Value: v, Stack: debug.Stack()}
+		}
+		g.mu.Lock()
+		c.wg.Done()
+		// the call may have been forgotten and replaced by a new one
+		if g.m[key] == c {
+			delete(g.m, key)
+		}
+		for _, ch := range c.chans {
+			ch <- Result[V]{Val: c.val, Err: c.err, Shared: c.dups > 0}
+		}
+		g.mu.Unlock()
+	}()
+	c.val, c.err = fc(key)
+}
@@ -0,0 +1,66 @@
+// Package gutils
+// Create-time: 2026/10/17
+package gutils
+
+import (
+	"errors"
+	"strings"
+)
+
+// MultiError is a list of errors reported as a single error.
+type MultiError []error
+
+func (m MultiError) Error() string {
+	ss := make([]string, 0, len(m))
+	for _, e := range m {
+		ss = append(ss, e.Error())
+	}
+	return strings.Join(ss, "; ")
+}
+
+// Unwrap returns the errors in m. Since go1.20, errors.Is and errors.As look into the result by themselves.
+func (m MultiError) Unwrap() []error {
+	return m
+}
+
+// Is reports whether any error in m matches target.
+// The module supports go1.18, whose errors.Is ignores Unwrap() []error, so this method is what makes
+// errors.Is find the errors in m there. It is redundant but harmless since go1.20, keep it while go.mod says go1.18.
+func (m MultiError) Is(target error) bool {
+	for _, e := range m {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error in m that matches target.
+// Like Is, it exists for toolchains before go1.20, whose errors.As ignores Unwrap() []error.
+func (m MultiError) As(target any) bool {
+	for _, e := range m {
+		if errors.As(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// JoinErrors returns a MultiError holding the non-nil errors of errs.
+// It returns nil if there is no non-nil error, and the error itself if there is only one.
+func JoinErrors(errs ...error) error {
+	var ret MultiError
+	for _, e := range errs {
+		if e != nil {
+			ret = append(ret, e)
+		}
+	}
+	switch len(ret) {
+	case 0:
+		return nil
+	case 1:
+		return ret[0]
+	default:
+		return ret
+	}
+}
@@ -6,7 +6,6 @@ package gutils
 
 import (
 	"context"
-	"sync"
 
 	"github.com/hyphennn/glambda/gvalue"
 )
@@ -23,6 +22,7 @@ type Pair[F, S any] struct {
 	Second S
 }
 
+// MakePair returns a pointer to a new Pair, use MakeTuple2 if you want a value.
 func MakePair[F, S any](f F, s S) *Pair[F, S] {
 	return &Pair[F, S]{First: f, Second: s}
 }
@@ -39,18 +39,22 @@ func FastAssert[T any](v any) T {
 	return t
 }
 
+// MustDo calls fc with key and returns its value, the error is dropped and the zero value of V is returned instead.
+// Use gresult.Do if the error matters.
 func MustDo[K, V any](key K, fc func(K) (V, error)) V {
 	return MustEasyDo(func() (V, error) {
 		return fc(key)
 	})
 }
 
+// MustDoCtx is the context-aware variant of MustDo, use gresult.DoCtx if the error matters.
 func MustDoCtx[K, V any](ctx context.Context, key K, fc func(context.Context, K) (V, error)) V {
 	return MustEasyDo(func() (V, error) {
 		return fc(ctx, key)
 	})
 }
 
+// MustEasyDo is the variant of MustDo without key, use gresult.EasyDo if the error matters.
 func MustEasyDo[V any](fc func() (V, error)) V {
 	v, err := fc()
 	if err != nil {
@@ -63,35 +67,17 @@ func NoError[T any](t T) (T, error) {
 	return t, nil
 }
 
-type ch[T any] chan T
-
-type SafeChan[T any] struct {
-	ch[T]
-	once sync.Once
-}
-
-func NewSafeChan[T any](size ...int) *SafeChan[T] {
-	if len(size) == 0 {
-		return &SafeChan[T]{make(chan T), sync.Once{}}
-	}
-	return &SafeChan[T]{make(chan T, size[0]), sync.Once{}}
-}
-
-func (s *SafeChan[T]) Listen() (t T) {
-	t = <-s.ch
-	return
-}
-
-func (s *SafeChan[T]) Send(t T) {
-	s.ch <- t
-}
-
-func (s *SafeChan[T]) Close() {
-	s.once.Do(func() {
-		close(s.ch)
-	})
-}
-
+// Paging returns the page numbered offset of arr, with at most limit items on each page, the first page is numbered 0.
+// It returns an empty slice if offset is negative or limit is not positive.
+//
+// Deprecated: despite its name, offset is a page number. Use gpage.ByOffset or gpage.ByPageNo instead,
+// which also return the page metadata.
 func Paging[T any](arr []T, offset, limit int) []T {
+	if offset < 0 || limit <= 0 {
+		return arr[:0:0]
+	}
+	if offset > len(arr)/limit {
+		return arr[len(arr):]
+	}
 	return arr[TernaryForm((offset)*limit <= len(arr), (offset)*limit, len(arr)):TernaryForm((offset+1)*limit <= len(arr), (offset+1)*limit, len(arr))]
 }
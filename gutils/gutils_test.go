@@ -0,0 +1,38 @@
+// Package gutils
+// Create-time: 2026/10/17
+package gutils_test
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/hyphennn/glambda/gutils"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestPaging(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, []int{3, 4}, gutils.Paging(s, 1, 2))
+	assert.Equal(t, []int{5}, gutils.Paging(s, 2, 2))
+	assert.Equal(t, []int{}, gutils.Paging(s, 3, 2))
+	assert.Equal(t, []int{}, gutils.Paging(s, -1, 2))
+	assert.Equal(t, []int{}, gutils.Paging(s, 0, 0))
+	assert.Equal(t, []int{}, gutils.Paging(s, 1<<62, 4))
+}
+
+func TestJoinErrors(t *testing.T) {
+	assert.Nil(t, gutils.JoinErrors(nil, nil))
+	assert.Equal(t, fs.ErrClosed, gutils.JoinErrors(nil, fs.ErrClosed))
+
+	pe := &fs.PathError{Op: "open", Path: "a", Err: fs.ErrNotExist}
+	err := gutils.JoinErrors(fs.ErrClosed, nil, pe)
+	assert.Equal(t, "file already closed; open a: file does not exist", err.Error())
+	// go1.18 的 errors.Is/As 只能通过 MultiError 自身的 Is/As 找到其中的错误
+	assert.True(t, errors.Is(err, fs.ErrClosed))
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+	assert.False(t, errors.Is(err, fs.ErrExist))
+	var got *fs.PathError
+	assert.True(t, errors.As(err, &got))
+	assert.Equal(t, "a", got.Path)
+}
@@ -0,0 +1,168 @@
+// Package gutils
+// Create-time: 2026/10/17
+package gutils
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrChanClosed is returned by the methods of SafeChan when it is closed.
+var ErrChanClosed = errors.New("gutils: SafeChan is closed")
+
+// SafeChan is a channel which can be closed more than once, and can be sent to
+// after it is closed: sending to a closed SafeChan fails instead of panicking.
+type SafeChan[T any] struct {
+	ch chan T
+	// done is closed at the beginning of Close to wake up blocked senders.
+	done chan struct{}
+	// mu guards closed: senders hold the read lock while sending, so that ch is never closed under them.
+	mu     sync.RWMutex
+	closed bool
+	once   sync.Once
+}
+
+func NewSafeChan[T any](size ...int) *SafeChan[T] {
+	n := 0
+	if len(size) != 0 {
+		n = size[0]
+	}
+	return &SafeChan[T]{ch: make(chan T, n), done: make(chan struct{})}
+}
+
+// Listen receives a value, it returns the zero value of T if s is closed and drained.
+// Use Recv to tell a closed channel from a zero value.
+func (s *SafeChan[T]) Listen() (t T) {
+	t = <-s.ch
+	return
+}
+
+// Recv receives a value, the bool is false if s is closed and drained.
+func (s *SafeChan[T]) Recv() (T, bool) {
+	t, ok := <-s.ch
+	return t, ok
+}
+
+// RecvCtx receives a value, it returns ErrChanClosed if s is closed and drained,
+// or the error of ctx if ctx is done first.
+func (s *SafeChan[T]) RecvCtx(ctx context.Context) (T, error) {
+	select {
+	case t, ok := <-s.ch:
+		if !ok {
+			return t, ErrChanClosed
+		}
+		return t, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// RecvTimeout is RecvCtx with a timeout, it returns context.DeadlineExceeded on timeout.
+func (s *SafeChan[T]) RecvTimeout(d time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return s.RecvCtx(ctx)
+}
+
+// Send sends value t, blocking until it is received or buffered.
+// It is a no-op if s is closed before t is sent, use TrySend or SendCtx to know whether t is sent.
+func (s *SafeChan[T]) Send(t T) {
+	_ = s.SendCtx(context.Background(), t)
+}
+
+// TrySend sends value t without blocking.
+// It returns false if s is closed, or t cannot be sent immediately.
+func (s *SafeChan[T]) TrySend(t T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return false
+	}
+	select {
+	case s.ch <- t:
+		return true
+	default:
+		return false
+	}
+}
+
+// SendCtx sends value t, it returns ErrChanClosed if s is closed before t is sent,
+// or the error of ctx if ctx is done first.
+func (s *SafeChan[T]) SendCtx(ctx context.Context, t T) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return ErrChanClosed
+	}
+	select {
+	case s.ch <- t:
+		return nil
+	case <-s.done:
+		return ErrChanClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SendTimeout is SendCtx with a timeout, it returns context.DeadlineExceeded on timeout.
+func (s *SafeChan[T]) SendTimeout(t T, d time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return s.SendCtx(ctx, t)
+}
+
+// Close closes s, it is safe to call Close more than once and concurrently with sends.
+// Values already buffered can still be received after Close.
+func (s *SafeChan[T]) Close() {
+	s.once.Do(func() {
+		close(s.done)
+		s.mu.Lock()
+		s.closed = true
+		close(s.ch)
+		s.mu.Unlock()
+	})
+}
+
+// IsClosed returns true if Close has been called.
+func (s *SafeChan[T]) IsClosed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Len returns the number of buffered values.
+func (s *SafeChan[T]) Len() int {
+	return len(s.ch)
+}
+
+// Cap returns the buffer size.
+func (s *SafeChan[T]) Cap() int {
+	return cap(s.ch)
+}
+
+// Drain receives all values currently buffered without blocking.
+func (s *SafeChan[T]) Drain() []T {
+	ret := make([]T, 0, len(s.ch))
+	for {
+		select {
+		case t, ok := <-s.ch:
+			if !ok {
+				return ret
+			}
+			ret = append(ret, t)
+		default:
+			return ret
+		}
+	}
+}
+
+// Chan returns the underlying channel for receiving, e.g. in a select statement.
+func (s *SafeChan[T]) Chan() <-chan T {
+	return s.ch
+}
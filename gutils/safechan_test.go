@@ -0,0 +1,127 @@
+// Package gutils
+// Create-time: 2026/10/17
+package gutils_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyphennn/glambda/gutils"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestSafeChan(t *testing.T) {
+	s := gutils.NewSafeChan[int](2)
+	assert.Equal(t, 2, s.Cap())
+	s.Send(1)
+	assert.True(t, s.TrySend(2))
+	assert.False(t, s.TrySend(3))
+	assert.Equal(t, 2, s.Len())
+
+	v, ok := s.Recv()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	s.Close()
+	s.Close()
+	assert.True(t, s.IsClosed())
+	s.Send(3) // no-op after close
+	assert.False(t, s.TrySend(3))
+	assert.Equal(t, gutils.ErrChanClosed, s.SendCtx(context.Background(), 3))
+
+	// 关闭后仍可读出缓冲中的值
+	assert.Equal(t, 2, s.Listen())
+	v, ok = s.Recv()
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+	_, err := s.RecvCtx(context.Background())
+	assert.Equal(t, gutils.ErrChanClosed, err)
+}
+
+func TestSafeChanTimeout(t *testing.T) {
+	s := gutils.NewSafeChan[int]()
+	assert.Equal(t, context.DeadlineExceeded, s.SendTimeout(1, time.Millisecond))
+	_, err := s.RecvTimeout(time.Millisecond)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, s.SendCtx(ctx, 1))
+
+	go func() { s.Send(1) }()
+	v, err := s.RecvTimeout(time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, v)
+}
+
+func TestSafeChanDrain(t *testing.T) {
+	s := gutils.NewSafeChan[int](3)
+	s.Send(1)
+	s.Send(2)
+	assert.Equal(t, []int{1, 2}, s.Drain())
+	assert.Equal(t, []int{}, s.Drain())
+	s.Send(3)
+	s.Close()
+	assert.Equal(t, []int{3}, s.Drain())
+
+	select {
+	case _, ok := <-s.Chan():
+		assert.False(t, ok)
+	default:
+		t.Error("closed channel should be ready")
+	}
+}
+
+func TestSafeChanCloseUnblocksSend(t *testing.T) {
+	s := gutils.NewSafeChan[int]()
+	done := make(chan error)
+	go func() { done <- s.SendCtx(context.Background(), 1) }()
+	time.Sleep(10 * time.Millisecond)
+	s.Close()
+	assert.Equal(t, gutils.ErrChanClosed, <-done)
+
+	// Send 可以作为 func(T) 使用，关闭后不阻塞也不 panic
+	var send func(int) = s.Send
+	send(2)
+}
+
+// TestSafeChanSendAfterCloseRace is meant to be run with -race.
+func TestSafeChanSendAfterCloseRace(t *testing.T) {
+	for round := 0; round < 50; round++ {
+		s := gutils.NewSafeChan[int](4)
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 50; i++ {
+					switch i % 3 {
+					case 0:
+						s.TrySend(i)
+					case 1:
+						_ = s.SendTimeout(i, time.Microsecond)
+					default:
+						if g == 0 && i > 25 {
+							s.Close()
+						}
+					}
+				}
+			}(g)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if _, ok := s.Recv(); !ok {
+					return
+				}
+			}
+		}()
+		time.Sleep(time.Millisecond)
+		s.Close()
+		wg.Wait()
+		assert.True(t, s.IsClosed())
+	}
+}
@@ -2,17 +2,28 @@
 // Create-time: 2024/12/24
 package gutils
 
+import (
+	"github.com/hyphennn/glambda/goption"
+)
+
+// SliceSet is an insertion-ordered map.
+// Values are kept in a slice, and m maps each key to the index of its value.
+// Deleted entries are left as tombstones and compacted once they make up half of the slice.
 type SliceSet[K comparable, V any] struct {
 	m     map[K]int
 	slice []V
+	keys  []K
+	// dead marks tombstones by index, it is nil when there is no tombstone.
+	dead  []bool
+	nDead int
 }
 
 func NewSliceSet[K comparable, V any]() *SliceSet[K, V] {
-	return &SliceSet[K, V]{map[K]int{}, []V{}}
+	return &SliceSet[K, V]{m: map[K]int{}, slice: []V{}}
 }
 
 func NewSliceSetFormSlice[K comparable](ss ...[]K) *SliceSet[K, K] {
-	ret := &SliceSet[K, K]{map[K]int{}, []K{}}
+	ret := NewSliceSet[K, K]()
 	for _, s := range ss {
 		for _, k := range s {
 			ret.Upsert(k, k)
@@ -24,12 +35,43 @@ func NewSliceSetFormSlice[K comparable](ss ...[]K) *SliceSet[K, K] {
 func (s *SliceSet[K, V]) insert(key K, value V) {
 	s.m[key] = len(s.slice)
 	s.slice = append(s.slice, value)
+	s.keys = append(s.keys, key)
+	if s.dead != nil {
+		s.dead = append(s.dead, false)
+	}
 }
 
 func (s *SliceSet[K, V]) update(key K, value V) {
 	s.slice[s.m[key]] = value
 }
 
+// compact removes tombstones and rewrites the index map.
+// It is only called on the write path, so that read methods are safe for concurrent use.
+func (s *SliceSet[K, V]) compact() {
+	if s.nDead == 0 {
+		return
+	}
+	j := 0
+	for i := range s.slice {
+		if s.dead[i] {
+			continue
+		}
+		s.slice[j], s.keys[j] = s.slice[i], s.keys[i]
+		s.m[s.keys[j]] = j
+		j++
+	}
+	// release references held by the tail
+	var (
+		zv V
+		zk K
+	)
+	for i := j; i < len(s.slice); i++ {
+		s.slice[i], s.keys[i] = zv, zk
+	}
+	s.slice, s.keys = s.slice[:j], s.keys[:j]
+	s.dead, s.nDead = nil, 0
+}
+
 func (s *SliceSet[K, V]) Insert(key K, value V) bool {
 	if _, ok := s.m[key]; ok {
 		return false
@@ -63,10 +105,114 @@ func (s *SliceSet[K, V]) Get(key K) (V, bool) {
 	return s.slice[i], ok
 }
 
+// GetOpt is the [goption.Option] variant of [SliceSet.Get].
+func (s *SliceSet[K, V]) GetOpt(key K) goption.Option[V] {
+	return goption.Of(s.Get(key))
+}
+
+// Delete removes key and its value, it returns false if key is not in the set.
+// The relative order of remaining entries is kept.
+func (s *SliceSet[K, V]) Delete(key K) bool {
+	i, ok := s.m[key]
+	if !ok {
+		return false
+	}
+	delete(s.m, key)
+	if s.dead == nil {
+		s.dead = make([]bool, len(s.slice))
+	}
+	s.dead[i] = true
+	s.nDead++
+	var zv V
+	s.slice[i] = zv
+	if s.nDead*2 >= len(s.slice) {
+		s.compact()
+	}
+	return true
+}
+
+// Len returns the number of entries.
+func (s *SliceSet[K, V]) Len() int {
+	return len(s.m)
+}
+
+// Keys returns the keys in insertion order as a new slice.
+func (s *SliceSet[K, V]) Keys() []K {
+	ret := make([]K, 0, s.Len())
+	s.Range(func(k K, _ V) bool {
+		ret = append(ret, k)
+		return true
+	})
+	return ret
+}
+
+// Range calls fc for each entry in insertion order until fc returns false.
+// fc must not modify s.
+func (s *SliceSet[K, V]) Range(fc func(K, V) bool) {
+	for i := range s.slice {
+		if s.nDead != 0 && s.dead[i] {
+			continue
+		}
+		if !fc(s.keys[i], s.slice[i]) {
+			return
+		}
+	}
+}
+
+// Pairs returns the entries in insertion order as key/value pairs.
+func (s *SliceSet[K, V]) Pairs() []Pair[K, V] {
+	ret := make([]Pair[K, V], 0, s.Len())
+	s.Range(func(k K, v V) bool {
+		ret = append(ret, Pair[K, V]{First: k, Second: v})
+		return true
+	})
+	return ret
+}
+
+// ToMap returns the entries as a new map.
+func (s *SliceSet[K, V]) ToMap() map[K]V {
+	ret := make(map[K]V, s.Len())
+	s.Range(func(k K, v V) bool {
+		ret[k] = v
+		return true
+	})
+	return ret
+}
+
+// Clone returns a compacted copy of s, s itself is left untouched.
+func (s *SliceSet[K, V]) Clone() *SliceSet[K, V] {
+	ret := &SliceSet[K, V]{
+		m:     make(map[K]int, s.Len()),
+		slice: make([]V, 0, s.Len()),
+		keys:  make([]K, 0, s.Len()),
+	}
+	s.Range(func(k K, v V) bool {
+		ret.insert(k, v)
+		return true
+	})
+	return ret
+}
+
+// GetSlice returns the values in insertion order as a new slice.
+// It never shares memory with s, since Delete and Update overwrite the values of s in place,
+// so the result is not affected by later writes to s and may be modified freely.
 func (s *SliceSet[K, V]) GetSlice() []V {
-	return s.slice
+	ret := make([]V, 0, s.Len())
+	s.Range(func(_ K, v V) bool {
+		ret = append(ret, v)
+		return true
+	})
+	return ret
 }
 
+// GetMap returns a copy of the index map from each key to the index of its value in [SliceSet.GetSlice].
+//
+// Deprecated: the index map is an implementation detail, use [SliceSet.Get], [SliceSet.Range] or [SliceSet.ToMap] instead.
 func (s *SliceSet[K, V]) GetMap() map[K]int {
-	return s.m
+	ret := make(map[K]int, s.Len())
+	s.Range(func(k K, _ V) bool {
+		ret[k] = len(ret)
+		return true
+	})
+	return ret
 }
@@ -0,0 +1,139 @@
+// Package gutils
+// Create-time: 2026/10/17
+package gutils_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/hyphennn/glambda/gutils"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestSliceSet(t *testing.T) {
+	s := gutils.NewSliceSet[string, int]()
+	assert.True(t, s.Insert("a", 1))
+	assert.True(t, s.Insert("b", 2))
+	assert.False(t, s.Insert("a", 3))
+	assert.True(t, s.Update("a", 10))
+	assert.False(t, s.Update("c", 3))
+	s.Upsert("c", 3)
+	assert.Equal(t, 3, s.Len())
+	assert.Equal(t, []int{10, 2, 3}, s.GetSlice())
+	assert.Equal(t, []string{"a", "b", "c"}, s.Keys())
+
+	v, ok := s.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+}
+
+func TestSliceSetDelete(t *testing.T) {
+	s := gutils.NewSliceSet[int, int]()
+	for i := 0; i < 10; i++ {
+		s.Insert(i, i*10)
+	}
+	assert.True(t, s.Delete(3))
+	assert.False(t, s.Delete(3))
+	assert.False(t, s.Delete(100))
+	assert.Equal(t, 9, s.Len())
+	_, ok := s.Get(3)
+	assert.False(t, ok)
+
+	// 删除后仍保持插入顺序，且索引在压缩后依然正确
+	for _, k := range []int{0, 5, 7, 9, 1} {
+		s.Delete(k)
+	}
+	assert.Equal(t, []int{2, 4, 6, 8}, s.Keys())
+	assert.Equal(t, []int{20, 40, 60, 80}, s.GetSlice())
+	for _, k := range s.Keys() {
+		v, ok := s.Get(k)
+		assert.True(t, ok)
+		assert.Equal(t, k*10, v)
+	}
+
+	// 重新插入已删除的 key 会排在末尾
+	s.Insert(3, 33)
+	s.Delete(4)
+	assert.Equal(t, []int{2, 6, 8, 3}, s.Keys())
+	assert.Equal(t, map[int]int{2: 20, 6: 60, 8: 80, 3: 33}, s.ToMap())
+	assert.Equal(t, map[int]int{2: 0, 6: 1, 8: 2, 3: 3}, s.GetMap())
+
+	for _, k := range s.Keys() {
+		s.Delete(k)
+	}
+	assert.Equal(t, 0, s.Len())
+	assert.Equal(t, []int{}, s.GetSlice())
+}
+
+func TestSliceSetRange(t *testing.T) {
+	s := gutils.NewSliceSetFormSlice([]int{1, 2, 3, 4})
+	s.Delete(2)
+	var ks []int
+	s.Range(func(k, v int) bool {
+		ks = append(ks, k)
+		return k < 3
+	})
+	assert.Equal(t, []int{1, 3}, ks)
+	assert.Equal(t,
+		[]gutils.Pair[int, int]{{First: 1, Second: 1}, {First: 3, Second: 3}, {First: 4, Second: 4}},
+		s.Pairs(),
+	)
+}
+
+func TestSliceSetClone(t *testing.T) {
+	s := gutils.NewSliceSetFormSlice([]int{1, 2, 3})
+	s.Delete(1)
+	c := s.Clone()
+	c.Insert(4, 4)
+	c.Delete(2)
+	assert.Equal(t, []int{2, 3}, s.Keys())
+	assert.Equal(t, []int{3, 4}, c.Keys())
+
+	m := s.GetMap()
+	m[3] = 100
+	v, ok := s.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+}
+
+func TestSliceSetGetSliceAliasing(t *testing.T) {
+	s := gutils.NewSliceSetFormSlice([]int{1, 2, 3, 4})
+	old := s.GetSlice()
+	// 之后的写操作不影响之前取得的结果
+	s.Delete(1)
+	s.Delete(2)
+	s.Upsert(3, 30)
+	assert.Equal(t, []int{1, 2, 3, 4}, old)
+	assert.Equal(t, []int{30, 4}, s.GetSlice())
+
+	// 修改结果也不影响 s
+	got := s.GetSlice()
+	got[0] = 100
+	v, _ := s.Get(3)
+	assert.Equal(t, 30, v)
+}
+
+func TestSliceSetConcurrentRead(t *testing.T) {
+	s := gutils.NewSliceSetFormSlice([]int{1, 2, 3, 4, 5})
+	s.Delete(2)
+	// 读方法不应修改 s，并发读在 -race 下必须安全
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, []int{1, 3, 4, 5}, s.Keys())
+			assert.Equal(t, []int{1, 3, 4, 5}, s.GetSlice())
+			assert.Equal(t, map[int]int{1: 0, 3: 1, 4: 2, 5: 3}, s.GetMap())
+			assert.Equal(t, []int{1, 3, 4, 5}, s.Clone().Keys())
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSliceSetGetOpt(t *testing.T) {
+	s := gutils.NewSliceSetFormSlice([]int{1, 2})
+	assert.Equal(t, 1, s.GetOpt(1).MustGet())
+	s.Delete(1)
+	assert.True(t, s.GetOpt(1).IsNone())
+}
@@ -0,0 +1,217 @@
+// Package gutils
+// Create-time: 2026/10/17
+package gutils
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+// Tuple2 is a value of two elements.
+// Unlike [MakePair], [MakeTuple2] returns a value, so it needs no heap allocation.
+// Tuple2 has the same fields as Pair, so they can be converted to each other directly:
+//
+//	Pair[int, string](MakeTuple2(1, "a"))
+//	Tuple2[int, string](*MakePair(1, "a"))
+//
+// Tuples of comparable elements can be compared with ==, and they are encoded to JSON as arrays.
+type Tuple2[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Tuple3 is a value of three elements, see [Tuple2].
+type Tuple3[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Tuple4 is a value of four elements, see [Tuple2].
+type Tuple4[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Tuple5 is a value of five elements, see [Tuple2].
+type Tuple5[A, B, C, D, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+// MakeTuple2 returns a Tuple2 of the given two elements.
+func MakeTuple2[A, B any](a A, b B) Tuple2[A, B] {
+	return Tuple2[A, B]{First: a, Second: b}
+}
+
+// MakeTuple3 returns a Tuple3 of the given three elements.
+func MakeTuple3[A, B, C any](a A, b B, c C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{First: a, Second: b, Third: c}
+}
+
+// MakeTuple4 returns a Tuple4 of the given four elements.
+func MakeTuple4[A, B, C, D any](a A, b B, c C, d D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{First: a, Second: b, Third: c, Fourth: d}
+}
+
+// MakeTuple5 returns a Tuple5 of the given five elements.
+func MakeTuple5[A, B, C, D, E any](a A, b B, c C, d D, e E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{First: a, Second: b, Third: c, Fourth: d, Fifth: e}
+}
+
+// TupleFromPair converts p to a Tuple2.
+func TupleFromPair[A, B any](p Pair[A, B]) Tuple2[A, B] {
+	return Tuple2[A, B](p)
+}
+
+// ToPair converts t to a Pair.
+func (t Tuple2[A, B]) ToPair() Pair[A, B] {
+	return Pair[A, B](t)
+}
+
+// Unpack returns the elements of t.
+func (t Tuple2[A, B]) Unpack() (A, B) {
+	return t.First, t.Second
+}
+
+func (t Tuple3[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return t.First, t.Second, t.Third, t.Fourth
+}
+
+func (t Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth
+}
+
+// MarshalJSON encodes t as a JSON array, e.g. [1,"a"].
+func (t Tuple2[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second})
+}
+
+// UnmarshalJSON decodes a JSON array of exactly two elements into t.
+func (t *Tuple2[A, B]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.First, &t.Second)
+}
+
+// MarshalJSON encodes t as a JSON array.
+func (t Tuple3[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third})
+}
+
+// UnmarshalJSON decodes a JSON array of exactly three elements into t.
+func (t *Tuple3[A, B, C]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.First, &t.Second, &t.Third)
+}
+
+// MarshalJSON encodes t as a JSON array.
+func (t Tuple4[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth})
+}
+
+// UnmarshalJSON decodes a JSON array of exactly four elements into t.
+func (t *Tuple4[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.First, &t.Second, &t.Third, &t.Fourth)
+}
+
+// MarshalJSON encodes t as a JSON array.
+func (t Tuple5[A, B, C, D, E]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth})
+}
+
+// UnmarshalJSON decodes a JSON array of exactly five elements into t.
+func (t *Tuple5[A, B, C, D, E]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth)
+}
+
+func unmarshalTuple(data []byte, elems ...any) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+	if len(raws) != len(elems) {
+		return fmt.Errorf("gutils: cannot unmarshal JSON array of %d elements into tuple of %d", len(raws), len(elems))
+	}
+	for i, raw := range raws {
+		if err := json.Unmarshal(raw, elems[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compare[T constraints.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// CompareTuple2 compares a and b lexicographically, it returns -1, 0 or 1.
+//
+// EXAMPLE:
+//
+//	CompareTuple2(MakeTuple2(1, "b"), MakeTuple2(1, "a")) => 1
+func CompareTuple2[A, B constraints.Ordered](a, b Tuple2[A, B]) int {
+	if c := compare(a.First, b.First); c != 0 {
+		return c
+	}
+	return compare(a.Second, b.Second)
+}
+
+// CompareTuple3 compares a and b lexicographically, it returns -1, 0 or 1.
+func CompareTuple3[A, B, C constraints.Ordered](a, b Tuple3[A, B, C]) int {
+	if c := CompareTuple2(MakeTuple2(a.First, a.Second), MakeTuple2(b.First, b.Second)); c != 0 {
+		return c
+	}
+	return compare(a.Third, b.Third)
+}
+
+// CompareTuple4 compares a and b lexicographically, it returns -1, 0 or 1.
+func CompareTuple4[A, B, C, D constraints.Ordered](a, b Tuple4[A, B, C, D]) int {
+	if c := CompareTuple3(MakeTuple3(a.First, a.Second, a.Third), MakeTuple3(b.First, b.Second, b.Third)); c != 0 {
+		return c
+	}
+	return compare(a.Fourth, b.Fourth)
+}
+
+// CompareTuple5 compares a and b lexicographically, it returns -1, 0 or 1.
+func CompareTuple5[A, B, C, D, E constraints.Ordered](a, b Tuple5[A, B, C, D, E]) int {
+	if c := CompareTuple4(MakeTuple4(a.First, a.Second, a.Third, a.Fourth), MakeTuple4(b.First, b.Second, b.Third, b.Fourth)); c != 0 {
+		return c
+	}
+	return compare(a.Fifth, b.Fifth)
+}
+
+// LessTuple2 reports whether a sorts before b, it can be passed to gslice.SortFunc directly.
+func LessTuple2[A, B constraints.Ordered](a, b Tuple2[A, B]) bool {
+	return CompareTuple2(a, b) < 0
+}
+
+// LessTuple3 reports whether a sorts before b.
+func LessTuple3[A, B, C constraints.Ordered](a, b Tuple3[A, B, C]) bool {
+	return CompareTuple3(a, b) < 0
+}
+
+// LessTuple4 reports whether a sorts before b.
+func LessTuple4[A, B, C, D constraints.Ordered](a, b Tuple4[A, B, C, D]) bool {
+	return CompareTuple4(a, b) < 0
+}
+
+// LessTuple5 reports whether a sorts before b.
+func LessTuple5[A, B, C, D, E constraints.Ordered](a, b Tuple5[A, B, C, D, E]) bool {
+	return CompareTuple5(a, b) < 0
+}
@@ -0,0 +1,62 @@
+// Package gutils
+// Create-time: 2026/10/17
+package gutils_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hyphennn/glambda/gslice"
+	"github.com/hyphennn/glambda/gutils"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func TestTuple(t *testing.T) {
+	t2 := gutils.MakeTuple2(1, "a")
+	a, b := t2.Unpack()
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "a", b)
+	assert.Equal(t, gutils.Pair[int, string]{First: 1, Second: "a"}, t2.ToPair())
+	assert.Equal(t, t2, gutils.TupleFromPair(*gutils.MakePair(1, "a")))
+	assert.True(t, t2 == gutils.MakeTuple2(1, "a"))
+
+	_, _, _, _, e := gutils.MakeTuple5(1, 2, 3, 4, "e").Unpack()
+	assert.Equal(t, "e", e)
+}
+
+func TestTupleJSON(t *testing.T) {
+	type payload struct {
+		Point gutils.Tuple3[int, int, string] `json:"point"`
+	}
+	bs, err := json.Marshal(payload{Point: gutils.MakeTuple3(1, 2, "x")})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"point":[1,2,"x"]}`, string(bs))
+
+	var p payload
+	assert.Nil(t, json.Unmarshal(bs, &p))
+	assert.Equal(t, gutils.MakeTuple3(1, 2, "x"), p.Point)
+
+	var t2 gutils.Tuple2[int, string]
+	assert.NotNil(t, json.Unmarshal([]byte(`[1,"a",2]`), &t2))
+	assert.NotNil(t, json.Unmarshal([]byte(`["a",1]`), &t2))
+	assert.NotNil(t, json.Unmarshal([]byte(`{"First":1}`), &t2))
+
+	var t5 gutils.Tuple5[int, int, int, int, []int]
+	assert.Nil(t, json.Unmarshal([]byte(`[1,2,3,4,[5]]`), &t5))
+	assert.Equal(t, []int{5}, t5.Fifth)
+	bs, err = json.Marshal(t5)
+	assert.Nil(t, err)
+	assert.Equal(t, `[1,2,3,4,[5]]`, string(bs))
+}
+
+func TestCompareTuple(t *testing.T) {
+	assert.Equal(t, 1, gutils.CompareTuple2(gutils.MakeTuple2(1, "b"), gutils.MakeTuple2(1, "a")))
+	assert.Equal(t, -1, gutils.CompareTuple2(gutils.MakeTuple2(0, "b"), gutils.MakeTuple2(1, "a")))
+	assert.Equal(t, 0, gutils.CompareTuple3(gutils.MakeTuple3(1, 2, 3), gutils.MakeTuple3(1, 2, 3)))
+	assert.Equal(t, -1, gutils.CompareTuple5(gutils.MakeTuple5(1, 2, 3, 4, 5), gutils.MakeTuple5(1, 2, 3, 4, 6)))
+	assert.True(t, gutils.LessTuple4(gutils.MakeTuple4(1, 2, 2, 9), gutils.MakeTuple4(1, 2, 3, 0)))
+
+	ts := []gutils.Tuple2[string, int]{{First: "b", Second: 1}, {First: "a", Second: 2}, {First: "a", Second: 1}}
+	gslice.SortFunc(ts, gutils.LessTuple2[string, int])
+	assert.Equal(t, []gutils.Tuple2[string, int]{{First: "a", Second: 1}, {First: "a", Second: 2}, {First: "b", Second: 1}}, ts)
+}
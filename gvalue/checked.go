@@ -0,0 +1,156 @@
+// Package gvalue
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gvalue
+
+import (
+	"unsafe"
+
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+func isSigned[T constraints.Integer]() bool {
+	var z T
+	return ^z < 0
+}
+
+// MaxOf returns the maximum value of integer type T.
+//
+// EXAMPLE:
+//
+//	MaxOf[int8]()   => 127
+//	MaxOf[uint16]() => 65535
+func MaxOf[T constraints.Integer]() T {
+	return ^MinOf[T]()
+}
+
+// MinOf returns the minimum value of integer type T.
+//
+// EXAMPLE:
+//
+//	MinOf[int8]() => -128
+//	MinOf[uint]() => 0
+func MinOf[T constraints.Integer]() T {
+	if !isSigned[T]() {
+		return 0
+	}
+	var z T
+	return T(1) << (unsafe.Sizeof(z)*8 - 1)
+}
+
+// AddChecked returns a+b, and false if it overflows T.
+//
+// EXAMPLE:
+//
+//	AddChecked[int8](100, 27) => (127, true)
+//	AddChecked[int8](100, 28) => (-128, false)
+func AddChecked[T constraints.Integer](a, b T) (T, bool) {
+	r := a + b
+	if isSigned[T]() {
+		return r, (b >= 0) == (r >= a)
+	}
+	return r, r >= a
+}
+
+// SubChecked returns a-b, and false if it overflows T.
+//
+// EXAMPLE:
+//
+//	SubChecked[uint](1, 2) => (math.MaxUint, false)
+func SubChecked[T constraints.Integer](a, b T) (T, bool) {
+	r := a - b
+	if isSigned[T]() {
+		return r, (b >= 0) == (r <= a)
+	}
+	return r, b <= a
+}
+
+// MulChecked returns a*b, and false if it overflows T.
+//
+// EXAMPLE:
+//
+//	MulChecked[int8](-64, 2) => (-128, true)
+//	MulChecked[int8](64, 2)  => (-128, false)
+func MulChecked[T constraints.Integer](a, b T) (T, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	r := a * b
+	// -1 * MinOf overflows, and MinOf / -1 overflows as well so the division check below misses it
+	if minusOne := ^T(0); isSigned[T]() && ((a == minusOne && b == MinOf[T]()) || (b == minusOne && a == MinOf[T]())) {
+		return r, false
+	}
+	return r, r/b == a
+}
+
+// SumChecked returns the sum of s, and false if it overflows T at any step.
+//
+// EXAMPLE:
+//
+//	SumChecked[uint8](100, 100, 100) => (44, false)
+//
+// HINT:
+//
+//   - Use [Mean] if you want the mean, it never overflows.
+func SumChecked[T constraints.Integer](s ...T) (T, bool) {
+	var ret T
+	ok := true
+	for _, v := range s {
+		var vok bool
+		ret, vok = AddChecked(ret, v)
+		ok = ok && vok
+	}
+	return ret, ok
+}
+
+// SaturatingAdd returns a+b, clamped to the range of T.
+//
+// EXAMPLE:
+//
+//	SaturatingAdd[int8](100, 100)   => 127
+//	SaturatingAdd[int8](-100, -100) => -128
+func SaturatingAdd[T constraints.Integer](a, b T) T {
+	r, ok := AddChecked(a, b)
+	if ok {
+		return r
+	}
+	if b < 0 {
+		return MinOf[T]()
+	}
+	return MaxOf[T]()
+}
+
+// SaturatingSub returns a-b, clamped to the range of T.
+//
+// EXAMPLE:
+//
+//	SaturatingSub[uint8](1, 2)    => 0
+//	SaturatingSub[int8](100, -50) => 127
+func SaturatingSub[T constraints.Integer](a, b T) T {
+	r, ok := SubChecked(a, b)
+	if ok {
+		return r
+	}
+	if isSigned[T]() && b < 0 {
+		return MaxOf[T]()
+	}
+	return MinOf[T]()
+}
+
+// SaturatingMul returns a*b, clamped to the range of T.
+//
+// EXAMPLE:
+//
+//	SaturatingMul[int8](-64, 3) => -128
+//	SaturatingMul[uint8](16, 16) => 255
+func SaturatingMul[T constraints.Integer](a, b T) T {
+	r, ok := MulChecked(a, b)
+	if ok {
+		return r
+	}
+	if (a < 0) != (b < 0) {
+		return MinOf[T]()
+	}
+	return MaxOf[T]()
+}
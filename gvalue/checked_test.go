@@ -0,0 +1,142 @@
+// Package gvalue
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gvalue_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/hyphennn/glambda/gvalue"
+	"github.com/hyphennn/glambda/internal/assert"
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+func toBig[T constraints.Integer](v T) *big.Int {
+	if gvalue.MinOf[T]() < 0 {
+		return big.NewInt(int64(v))
+	}
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+// edges returns the interesting values of T around 0 and its bounds.
+func edges[T constraints.Integer]() []T {
+	mn, mx := gvalue.MinOf[T](), gvalue.MaxOf[T]()
+	ret := []T{0, 1, 2, 3, mx, mx - 1, mx / 2, mx/2 + 1, mn, mn + 1, mn / 2}
+	if mn < 0 {
+		var one T = 1
+		ret = append(ret, -one, -one-one, mn/2-1, mn/2+1)
+	}
+	return ret
+}
+
+// checkOps checks the checked and saturating operations of T against math/big on all pairs of vs.
+func checkOps[T constraints.Integer](t *testing.T, vs []T) {
+	t.Helper()
+	lo, hi := toBig(gvalue.MinOf[T]()), toBig(gvalue.MaxOf[T]())
+	clamp := func(r *big.Int) (T, bool) {
+		switch {
+		case r.Cmp(lo) < 0:
+			return gvalue.MinOf[T](), false
+		case r.Cmp(hi) > 0:
+			return gvalue.MaxOf[T](), false
+		}
+		if r.Sign() < 0 {
+			return T(r.Int64()), true
+		}
+		return T(r.Uint64()), true
+	}
+	type op struct {
+		checked    func(T, T) (T, bool)
+		saturating func(T, T) T
+		exact      func(x, y *big.Int) *big.Int
+	}
+	ops := []op{
+		{gvalue.AddChecked[T], gvalue.SaturatingAdd[T], new(big.Int).Add},
+		{gvalue.SubChecked[T], gvalue.SaturatingSub[T], new(big.Int).Sub},
+		{gvalue.MulChecked[T], gvalue.SaturatingMul[T], new(big.Int).Mul},
+	}
+	for i, o := range ops {
+		for _, a := range vs {
+			for _, b := range vs {
+				want, wantOK := clamp(o.exact(toBig(a), toBig(b)))
+				got, ok := o.checked(a, b)
+				if ok != wantOK || (ok && got != want) || o.saturating(a, b) != want {
+					t.Fatalf("op %d on %T(%v, %v): got (%v, %v), saturating %v, want (%v, %v)",
+						i, a, a, b, got, ok, o.saturating(a, b), want, wantOK)
+				}
+			}
+		}
+	}
+}
+
+func TestMinMaxOf(t *testing.T) {
+	assert.Equal(t, int8(math.MinInt8), gvalue.MinOf[int8]())
+	assert.Equal(t, int8(math.MaxInt8), gvalue.MaxOf[int8]())
+	assert.Equal(t, int16(math.MinInt16), gvalue.MinOf[int16]())
+	assert.Equal(t, int16(math.MaxInt16), gvalue.MaxOf[int16]())
+	assert.Equal(t, int32(math.MinInt32), gvalue.MinOf[int32]())
+	assert.Equal(t, int32(math.MaxInt32), gvalue.MaxOf[int32]())
+	assert.Equal(t, int64(math.MinInt64), gvalue.MinOf[int64]())
+	assert.Equal(t, int64(math.MaxInt64), gvalue.MaxOf[int64]())
+	assert.Equal(t, math.MinInt, gvalue.MinOf[int]())
+	assert.Equal(t, math.MaxInt, gvalue.MaxOf[int]())
+	assert.Equal(t, uint8(0), gvalue.MinOf[uint8]())
+	assert.Equal(t, uint8(math.MaxUint8), gvalue.MaxOf[uint8]())
+	assert.Equal(t, uint16(math.MaxUint16), gvalue.MaxOf[uint16]())
+	assert.Equal(t, uint32(math.MaxUint32), gvalue.MaxOf[uint32]())
+	assert.Equal(t, uint64(math.MaxUint64), gvalue.MaxOf[uint64]())
+	assert.Equal(t, uint(math.MaxUint), gvalue.MaxOf[uint]())
+	assert.Equal(t, ^uintptr(0), gvalue.MaxOf[uintptr]())
+
+	type myInt int8
+	assert.Equal(t, myInt(127), gvalue.MaxOf[myInt]())
+}
+
+func TestCheckedExhaustive8(t *testing.T) {
+	var i8s []int8
+	for v := math.MinInt8; v <= math.MaxInt8; v++ {
+		i8s = append(i8s, int8(v))
+	}
+	checkOps(t, i8s)
+
+	var u8s []uint8
+	for v := 0; v <= math.MaxUint8; v++ {
+		u8s = append(u8s, uint8(v))
+	}
+	checkOps(t, u8s)
+}
+
+func TestCheckedEdges(t *testing.T) {
+	checkOps(t, edges[int]())
+	checkOps(t, edges[int8]())
+	checkOps(t, edges[int16]())
+	checkOps(t, edges[int32]())
+	checkOps(t, edges[int64]())
+	checkOps(t, edges[uint]())
+	checkOps(t, edges[uint8]())
+	checkOps(t, edges[uint16]())
+	checkOps(t, edges[uint32]())
+	checkOps(t, edges[uint64]())
+	checkOps(t, edges[uintptr]())
+}
+
+func TestSumChecked(t *testing.T) {
+	s, ok := gvalue.SumChecked[uint8](100, 100, 100)
+	assert.False(t, ok)
+	assert.Equal(t, uint8(44), s)
+
+	s2, ok := gvalue.SumChecked[int8](100, 27, -50)
+	assert.True(t, ok)
+	assert.Equal(t, int8(77), s2)
+
+	// an intermediate overflow is reported even if the final sum fits
+	_, ok = gvalue.SumChecked[int8](100, 100, -100)
+	assert.False(t, ok)
+
+	s3, ok := gvalue.SumChecked[int]()
+	assert.True(t, ok)
+	assert.Equal(t, 0, s3)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hyphennn/glambda/internal/constraints"
 )
 
+// Sum returns the sum of s, integers wrap around on overflow, use SumChecked to detect it.
 func Sum[T constraints.Addable](s ...T) T {
 	var ret T
 	for _, v := range s {
@@ -0,0 +1,282 @@
+// Package gvalue
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gvalue
+
+import (
+	"math"
+	"sort"
+
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+// Mean returns the arithmetic mean of s, or NaN if s is empty.
+// Values are summed as float64, so unlike Sum it does not overflow on integers.
+//
+// EXAMPLE:
+//
+//	Mean(1, 2, 3, 4) => 2.5
+//	Mean[int]()      => NaN
+func Mean[T constraints.Number](s ...T) float64 {
+	var st Stats[T]
+	st.Add(s...)
+	return st.Mean()
+}
+
+// Median returns the median of s, or NaN if s is empty.
+// The median of an even number of values is the mean of the two middle ones.
+//
+// EXAMPLE:
+//
+//	Median(3, 1, 2)    => 2
+//	Median(4, 1, 3, 2) => 2.5
+func Median[T constraints.Number](s ...T) float64 {
+	return Percentile(50, s...)
+}
+
+// Mode returns the most frequent value of s, the smallest one is returned if there is a tie.
+// It returns false if s is empty.
+//
+// EXAMPLE:
+//
+//	Mode(1, 2, 2, 3, 3) => (2, true)
+func Mode[T constraints.Number](s ...T) (T, bool) {
+	var (
+		ret T
+		max int
+	)
+	cnt := make(map[T]int, len(s))
+	for _, v := range s {
+		cnt[v]++
+		c := cnt[v]
+		if c > max || (c == max && v < ret) {
+			ret, max = v, c
+		}
+	}
+	return ret, max > 0
+}
+
+// PercentileMethod is the way to interpolate when a percentile lies between two values.
+// Given the sorted values x and the rank r = p/100*(len(x)-1) lying between indexes i and j = i+1:
+type PercentileMethod int
+
+const (
+	// Linear returns x[i] + (x[j]-x[i])*(r-i), it is the default method.
+	Linear PercentileMethod = iota
+	// Lower returns x[i].
+	Lower
+	// Higher returns x[j].
+	Higher
+	// Nearest returns x[i] or x[j], whichever is nearer to r, x[j] if r is in the middle.
+	Nearest
+	// Midpoint returns (x[i]+x[j])/2.
+	Midpoint
+)
+
+// Percentile returns the p-th percentile of s with Linear interpolation.
+// It returns NaN if s is empty or p is out of [0, 100].
+//
+// EXAMPLE:
+//
+//	Percentile(90, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10) => 9.1
+func Percentile[T constraints.Number](p float64, s ...T) float64 {
+	return PercentileBy(Linear, p, s...)
+}
+
+// PercentileBy returns the p-th percentile of s with the given interpolation method.
+// It returns NaN if s is empty or p is out of [0, 100].
+//
+// EXAMPLE:
+//
+//	PercentileBy(Lower, 50, 1, 2, 3, 4)    => 2
+//	PercentileBy(Midpoint, 50, 1, 2, 3, 4) => 2.5
+func PercentileBy[T constraints.Number](method PercentileMethod, p float64, s ...T) float64 {
+	if len(s) == 0 || !(p >= 0 && p <= 100) {
+		return math.NaN()
+	}
+	xs := make([]float64, 0, len(s))
+	for _, v := range s {
+		xs = append(xs, float64(v))
+	}
+	sort.Float64s(xs)
+
+	r := p / 100 * float64(len(xs)-1)
+	i := int(math.Floor(r))
+	j := int(math.Ceil(r))
+	switch method {
+	case Lower:
+		return xs[i]
+	case Higher:
+		return xs[j]
+	case Nearest:
+		if r-float64(i) < 0.5 {
+			return xs[i]
+		}
+		return xs[j]
+	case Midpoint:
+		return (xs[i] + xs[j]) / 2
+	default:
+		return xs[i] + (xs[j]-xs[i])*(r-float64(i))
+	}
+}
+
+// Variance returns the population variance of s, or NaN if s is empty.
+func Variance[T constraints.Number](s ...T) float64 {
+	var st Stats[T]
+	st.Add(s...)
+	return st.Variance()
+}
+
+// SampleVariance returns the sample variance of s, or NaN if s has less than two values.
+func SampleVariance[T constraints.Number](s ...T) float64 {
+	var st Stats[T]
+	st.Add(s...)
+	return st.SampleVariance()
+}
+
+// StdDev returns the population standard deviation of s, or NaN if s is empty.
+//
+// EXAMPLE:
+//
+//	StdDev(2, 4, 4, 4, 5, 5, 7, 9) => 2
+func StdDev[T constraints.Number](s ...T) float64 {
+	return math.Sqrt(Variance(s...))
+}
+
+// SampleStdDev returns the sample standard deviation of s, or NaN if s has less than two values.
+func SampleStdDev[T constraints.Number](s ...T) float64 {
+	return math.Sqrt(SampleVariance(s...))
+}
+
+// Histogram counts the values of s in buckets, which are upper bounds sorted in ascending order.
+// The i-th count is the number of values in (buckets[i-1], buckets[i]], and the last count is
+// the number of values greater than the last bound, so len(buckets)+1 counts are returned.
+//
+// EXAMPLE:
+//
+//	Histogram([]int{10, 100}, 1, 10, 11, 100, 1000) => []int{2, 2, 1}
+func Histogram[T constraints.Number](buckets []T, s ...T) []int {
+	ret := make([]int, len(buckets)+1)
+	for _, v := range s {
+		i := sort.Search(len(buckets), func(i int) bool {
+			return v <= buckets[i]
+		})
+		ret[i]++
+	}
+	return ret
+}
+
+// Stats is a streaming accumulator of count, sum, min, max, mean and variance.
+// The variance is computed with Welford's algorithm, so it stays accurate for large inputs.
+// The zero value of Stats is ready to use.
+//
+// A Stats is not safe for concurrent use, give each goroutine its own Stats and [Stats.Merge] them.
+//
+// EXAMPLE:
+//
+//	var st Stats[int]
+//	st.Add(latencies...)
+//	st.Mean(), st.StdDev()
+type Stats[T constraints.Number] struct {
+	n        int
+	sum      float64
+	mean     float64
+	m2       float64
+	min, max T
+}
+
+// Add adds values to st.
+func (st *Stats[T]) Add(vs ...T) {
+	for _, v := range vs {
+		if st.n == 0 || v < st.min {
+			st.min = v
+		}
+		if st.n == 0 || v > st.max {
+			st.max = v
+		}
+		x := float64(v)
+		st.n++
+		st.sum += x
+		d := x - st.mean
+		st.mean += d / float64(st.n)
+		st.m2 += d * (x - st.mean)
+	}
+}
+
+// Merge adds all values added to o to st, as if they were added to st directly.
+func (st *Stats[T]) Merge(o *Stats[T]) {
+	if o.n == 0 {
+		return
+	}
+	if st.n == 0 {
+		*st = *o
+		return
+	}
+	n := st.n + o.n
+	d := o.mean - st.mean
+	st.m2 += o.m2 + d*d*float64(st.n)*float64(o.n)/float64(n)
+	st.mean += d * float64(o.n) / float64(n)
+	st.sum += o.sum
+	st.n = n
+	if o.min < st.min {
+		st.min = o.min
+	}
+	if o.max > st.max {
+		st.max = o.max
+	}
+}
+
+// Count returns the number of values added.
+func (st *Stats[T]) Count() int {
+	return st.n
+}
+
+// Sum returns the sum of values as float64.
+func (st *Stats[T]) Sum() float64 {
+	return st.sum
+}
+
+// Min returns the minimum value, it returns false if no value is added.
+func (st *Stats[T]) Min() (T, bool) {
+	return st.min, st.n > 0
+}
+
+// Max returns the maximum value, it returns false if no value is added.
+func (st *Stats[T]) Max() (T, bool) {
+	return st.max, st.n > 0
+}
+
+// Mean returns the mean of values, or NaN if no value is added.
+func (st *Stats[T]) Mean() float64 {
+	if st.n == 0 {
+		return math.NaN()
+	}
+	return st.mean
+}
+
+// Variance returns the population variance of values, or NaN if no value is added.
+func (st *Stats[T]) Variance() float64 {
+	if st.n == 0 {
+		return math.NaN()
+	}
+	return st.m2 / float64(st.n)
+}
+
+// SampleVariance returns the sample variance of values, or NaN if less than two values are added.
+func (st *Stats[T]) SampleVariance() float64 {
+	if st.n < 2 {
+		return math.NaN()
+	}
+	return st.m2 / float64(st.n-1)
+}
+
+// StdDev returns the population standard deviation of values, or NaN if no value is added.
+func (st *Stats[T]) StdDev() float64 {
+	return math.Sqrt(st.Variance())
+}
+
+// SampleStdDev returns the sample standard deviation of values, or NaN if less than two values are added.
+func (st *Stats[T]) SampleStdDev() float64 {
+	return math.Sqrt(st.SampleVariance())
+}
@@ -0,0 +1,129 @@
+// Package gvalue
+// Author: hyphen
+// Copyright 2026 hyphen. All rights reserved.
+// Create-time: 2026/10/17
+package gvalue_test
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/hyphennn/glambda/gvalue"
+	"github.com/hyphennn/glambda/internal/assert"
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestMeanMedianMode(t *testing.T) {
+	assert.Equal(t, 2.5, gvalue.Mean(1, 2, 3, 4))
+	assert.True(t, math.IsNaN(gvalue.Mean[int]()))
+	// the sum overflows int8 but the mean does not
+	assert.Equal(t, 100.0, gvalue.Mean[int8](100, 100, 100))
+
+	assert.Equal(t, 2.0, gvalue.Median(3, 1, 2))
+	assert.Equal(t, 2.5, gvalue.Median(4, 1, 3, 2))
+	assert.True(t, math.IsNaN(gvalue.Median[float64]()))
+
+	m, ok := gvalue.Mode(3, 3, 1, 2, 2)
+	assert.True(t, ok)
+	assert.Equal(t, 2, m)
+	_, ok = gvalue.Mode[int]()
+	assert.False(t, ok)
+}
+
+func TestPercentile(t *testing.T) {
+	s := []int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+	assert.True(t, near(9.1, gvalue.Percentile(90, s...)))
+	assert.Equal(t, 1.0, gvalue.Percentile(0, s...))
+	assert.Equal(t, 10.0, gvalue.Percentile(100, s...))
+	assert.True(t, math.IsNaN(gvalue.Percentile(101, s...)))
+	assert.True(t, math.IsNaN(gvalue.Percentile(math.NaN(), s...)))
+
+	s = []int{1, 2, 3, 4}
+	assert.Equal(t, 2.5, gvalue.PercentileBy(gvalue.Linear, 50, s...))
+	assert.Equal(t, 2.0, gvalue.PercentileBy(gvalue.Lower, 50, s...))
+	assert.Equal(t, 3.0, gvalue.PercentileBy(gvalue.Higher, 50, s...))
+	assert.Equal(t, 3.0, gvalue.PercentileBy(gvalue.Nearest, 50, s...))
+	assert.Equal(t, 2.0, gvalue.PercentileBy(gvalue.Nearest, 40, s...))
+	assert.Equal(t, 2.5, gvalue.PercentileBy(gvalue.Midpoint, 50, s...))
+	assert.Equal(t, 4.0, gvalue.PercentileBy(gvalue.Midpoint, 100, s...))
+}
+
+func TestVariance(t *testing.T) {
+	s := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	assert.Equal(t, 4.0, gvalue.Variance(s...))
+	assert.Equal(t, 2.0, gvalue.StdDev(s...))
+	assert.True(t, near(32.0/7, gvalue.SampleVariance(s...)))
+	assert.True(t, near(math.Sqrt(32.0/7), gvalue.SampleStdDev(s...)))
+	assert.True(t, math.IsNaN(gvalue.SampleVariance(1)))
+	assert.Equal(t, 0.0, gvalue.Variance(1))
+
+	// Welford stays accurate with a large offset where the naive formula loses all precision
+	big := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+	assert.Equal(t, 30.0, gvalue.SampleVariance(big...))
+}
+
+func TestHistogram(t *testing.T) {
+	assert.Equal(t, []int{2, 2, 1}, gvalue.Histogram([]int{10, 100}, 1, 10, 11, 100, 1000))
+	assert.Equal(t, []int{3}, gvalue.Histogram(nil, 1.0, 2, 3))
+	assert.Equal(t, []int{0, 0}, gvalue.Histogram([]int{1}))
+}
+
+func TestStats(t *testing.T) {
+	var st gvalue.Stats[int]
+	_, ok := st.Min()
+	assert.False(t, ok)
+	assert.True(t, math.IsNaN(st.Mean()))
+
+	st.Add(5, 1, 3)
+	assert.Equal(t, 3, st.Count())
+	assert.Equal(t, 9.0, st.Sum())
+	assert.Equal(t, 3.0, st.Mean())
+	mn, _ := st.Min()
+	mx, _ := st.Max()
+	assert.Equal(t, 1, mn)
+	assert.Equal(t, 5, mx)
+
+	// merged stats equal the stats of all values
+	var (
+		mu     sync.Mutex
+		merged gvalue.Stats[int]
+		wg     sync.WaitGroup
+		all    []int
+	)
+	for g := 0; g < 4; g++ {
+		var vs []int
+		for i := 0; i < 100; i++ {
+			vs = append(vs, g*1000+i*i%97)
+		}
+		all = append(all, vs...)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var local gvalue.Stats[int]
+			local.Add(vs...)
+			mu.Lock()
+			merged.Merge(&local)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	var want gvalue.Stats[int]
+	want.Add(all...)
+	assert.Equal(t, want.Count(), merged.Count())
+	assert.True(t, near(want.Mean(), merged.Mean()))
+	assert.True(t, math.Abs(want.Variance()-merged.Variance()) < 1e-6)
+	mn, _ = merged.Min()
+	mx, _ = merged.Max()
+	assert.Equal(t, 0, mn)
+	assert.Equal(t, 3096, mx)
+
+	var empty gvalue.Stats[int]
+	merged.Merge(&empty)
+	assert.Equal(t, 400, merged.Count())
+	empty.Merge(&st)
+	assert.Equal(t, 3.0, empty.Mean())
+}
@@ -0,0 +1,651 @@
+// Package engine implements the assertions of package internal/assert, gassert and gassert/require.
+//
+// Every assertion takes a skip argument, which is the number of wrapper frames between
+// the assertion called by the test and the function here, so that the literal argument
+// expressions of the call in the test source can be picked for the failure message.
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyphennn/glambda/internal/constraints"
+)
+
+// TestingT is the subset of *testing.T used by assertions.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+	FailNow()
+}
+
+// DefaultFloatDelta is the tolerance of comparing floats used by Equal and NotEqual.
+const DefaultFloatDelta = 1e-6
+
+var picker = newFormalArgPicker()
+
+type parsedFile struct {
+	src  string
+	fset *token.FileSet
+	ast  *ast.File
+}
+
+type formalArgPicker struct {
+	mu          sync.Mutex
+	parsedFiles map[string]*parsedFile
+}
+
+func newFormalArgPicker() *formalArgPicker {
+	return &formalArgPicker{
+		parsedFiles: make(map[string]*parsedFile),
+	}
+}
+
+func (p *formalArgPicker) parse(file string) *parsedFile {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pf, ok := p.parsedFiles[file]
+	if !ok {
+		// Read source
+		bs, _ := ioutil.ReadFile(file)
+		src := string(bs)
+		// Create the AST by parsing src.
+		fset := token.NewFileSet() // positions are relative to fset
+		ast, _ := parser.ParseFile(fset, file, src, 0)
+		pf = &parsedFile{
+			src:  src,
+			fset: fset,
+			ast:  ast,
+		}
+		p.parsedFiles[file] = pf
+	}
+	return pf
+}
+
+// Pick returns the literal expressions of the selected arguments of the call on the caller's line.
+// An expression is empty if it cannot be found, e.g. the source is not available.
+func (p *formalArgPicker) Pick(skip int, args ...int) ([]ast.Node, []string) {
+	// Get caller info from runtime.
+	_, file, line, _ := runtime.Caller(skip)
+	pc, _, _, _ := runtime.Caller(skip - 1)
+	fname := shortFuncName(runtime.FuncForPC(pc).Name())
+
+	fargs := make([]ast.Node, len(args))
+	fstrs := make([]string, len(args))
+
+	// Parse ast of tested file
+	pf := p.parse(file)
+	if pf.ast == nil {
+		return fargs, fstrs
+	}
+
+	// Pick selected arguments
+	ast.Inspect(pf.ast, func(n ast.Node) bool {
+		if n == nil {
+			return true
+		}
+		if pf.fset.Position(n.Pos()).Line != line {
+			return true
+		}
+		callExpr, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if !matchFuncName(p.nodeToString(pf, callExpr.Fun), fname) {
+			return true
+		}
+		for i, n := range args {
+			if n < len(callExpr.Args) {
+				fargs[i] = callExpr.Args[n]
+				fstrs[i] = p.nodeToString(pf, callExpr.Args[n])
+			}
+		}
+		return false
+	})
+
+	return fargs, fstrs
+}
+
+// shortFuncName converts full function name to short one:
+// "xxx.com/pkg/pkg.Func[...]" => "pkg.Func"
+func shortFuncName(fname string) string {
+	strv := strings.Split(fname, "/")
+	fname = strv[len(strv)-1]
+	// Strip possible type param list
+	strv = strings.Split(fname, "[")
+	return strv[0]
+}
+
+// matchFuncName reports whether the function expression of a call refers to fname.
+// The package may be imported by another name or by dot, and type arguments may be given explicitly.
+func matchFuncName(expr, fname string) bool {
+	expr = strings.Split(expr, "[")[0]
+	name := fname[strings.LastIndex(fname, ".")+1:]
+	return expr == fname || expr == name || strings.HasSuffix(expr, "."+name)
+}
+
+func (p *formalArgPicker) nodeToString(pf *parsedFile, n ast.Node) string {
+	start := pf.fset.Position(n.Pos()).Offset
+	stop := pf.fset.Position(n.End()).Offset
+	return pf.src[start:stop]
+}
+
+// pickArgs picks the arguments of the assertion called by the test,
+// skip is the skip argument of the assertion function calling pickArgs.
+func pickArgs(skip int, args ...int) []string {
+	// pickArgs, the assertion here and the wrappers are above the test
+	_, fstrs := picker.Pick(skip+3, args...)
+	return fstrs
+}
+
+// argOr returns the literal expression arg, or the value v if arg is not available.
+func argOr(arg string, v any) string {
+	if arg == "" {
+		return valueToString(v)
+	}
+	return arg
+}
+
+// nameOr returns the literal expression arg, or name if arg is not available.
+func nameOr(arg, name string) string {
+	if arg == "" {
+		return name
+	}
+	return arg
+}
+
+func valueToString(v any) string {
+	switch x := v.(type) {
+	case string:
+		return strconv.Quote(x)
+	default:
+		return fmt.Sprintf("%#v", v)
+	}
+}
+
+// Copied from https://github.com/stretchr/testify/blob/v1.7.0/assert/assertions.go#L334
+//
+// isEqual determines if two objects are considered equal.
+//
+// This function does no assertion of any kind.
+func isEqual(expected, actual any, delta float64) bool {
+	if expected == nil || actual == nil {
+		return expected == actual
+	}
+
+	switch exp := expected.(type) {
+	// handle byte slices more efficiently
+	case []byte:
+		act, ok := actual.([]byte)
+		if !ok {
+			return false
+		}
+		return bytes.Equal(exp, act)
+	// float point should not use ==
+	case float32:
+		act, ok := actual.(float32)
+		if !ok {
+			return false
+		}
+		return floatAlmostEqual(exp, act, delta)
+	case float64:
+		act, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		return floatAlmostEqual(exp, act, delta)
+	case []float32:
+		act, ok := actual.([]float32)
+		if !ok {
+			return false
+		}
+		if len(exp) != len(act) {
+			return false
+		}
+		for i := range exp {
+			if !floatAlmostEqual(exp[i], act[i], delta) {
+				return false
+			}
+		}
+		return true
+	case []float64:
+		act, ok := actual.([]float64)
+		if !ok {
+			return false
+		}
+		if len(exp) != len(act) {
+			return false
+		}
+		for i := range exp {
+			if !floatAlmostEqual(exp[i], act[i], delta) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(expected, actual)
+	}
+}
+
+func floatAlmostEqual[T constraints.Float](f1, f2 T, delta float64) bool {
+	return math.Abs(float64(f1-f2)) < delta
+}
+
+func Equal[T any](t TestingT, skip int, expected, actual T) bool {
+	t.Helper()
+	return EqualDelta(t, skip+1, expected, actual, DefaultFloatDelta)
+}
+
+// EqualDelta is Equal with floats, or slices of floats, compared with tolerance delta.
+func EqualDelta[T any](t TestingT, skip int, expected, actual T, delta float64) bool {
+	ok := isEqual(expected, actual, delta)
+	if !ok {
+		// Ask *testing.T to skip current function when printing file and
+		// line information.
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1, 2)
+		expectedArg := fstrs[0]
+		expectedArgStr := valueToString(expected)
+		if expectedArg == "" {
+			expectedArg = expectedArgStr
+		} else if expectedArg != expectedArgStr {
+			expectedArg += " (" + expectedArgStr + ")"
+		}
+		t.Errorf(`
+		Expected: %s
+
+		is equal to: %s
+
+		but got: %s`,
+			argOr(fstrs[1], actual), expectedArg, valueToString(actual))
+	}
+	return ok
+}
+
+func NotEqual[T any](t TestingT, skip int, expected, actual T) bool {
+	ok := isEqual(expected, actual, DefaultFloatDelta)
+	if ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1, 2)
+		expectedArg := fstrs[0]
+		expectedArgStr := valueToString(expected)
+		if expectedArg == "" {
+			expectedArg = expectedArgStr
+		} else if expectedArg != expectedArgStr {
+			expectedArg += " (" + expectedArgStr + ")"
+		}
+		t.Errorf(`
+		Expected: %s
+
+		is not equal to: %s
+
+		but got: %s`,
+			argOr(fstrs[1], actual), expectedArg, valueToString(actual))
+	}
+	return !ok
+}
+
+func InDelta[T constraints.Number](t TestingT, skip int, expected, actual T, delta float64) bool {
+	diff := math.Abs(float64(expected) - float64(actual))
+	ok := diff <= delta
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 2)
+		t.Errorf("Expect %s is within %v of %s, but got %s (difference %v)",
+			argOr(fstrs[0], actual), delta, valueToString(expected), valueToString(actual), diff)
+	}
+	return ok
+}
+
+func True[T ~bool](t TestingT, skip int, i T) bool {
+	if !i {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s is true, but got false", argOr(fstrs[0], i))
+	}
+	return bool(i)
+}
+
+func False[T ~bool](t TestingT, skip int, i T) bool {
+	if i {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s is false, but got true", argOr(fstrs[0], i))
+	}
+	return !bool(i)
+}
+
+// Copied from https://github.com/stretchr/testify/blob/v1.7.0/assert/assertions.go#L1003
+//
+// didPanic returns true if the function passed to it panics. Otherwise, it returns false.
+func didPanic(f func()) (bool, any, string) {
+	didPanic := false
+	var message any
+	var stack string
+	func() {
+
+		defer func() {
+			if message = recover(); message != nil {
+				didPanic = true
+				stack = string(debug.Stack())
+			}
+		}()
+
+		// call the target function
+		f()
+
+	}()
+
+	return didPanic, message, stack
+}
+
+func Panic(t TestingT, skip int, f func()) bool {
+	ok, _, _ := didPanic(f)
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Func %s should panic", nameOr(fstrs[0], "f"))
+	}
+	return ok
+}
+
+func NotPanic(t TestingT, skip int, f func()) bool {
+	ok, e, stack := didPanic(f)
+	if ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Func %s should not panic", nameOr(fstrs[0], "f"))
+		t.Errorf("Message: %v", e)
+		t.Errorf("Stack: %s", stack)
+	}
+	return !ok
+}
+
+// isNil reports whether i is nil, or a nil value of a nilable kind such as a typed nil pointer.
+func isNil(i any) bool {
+	if i == nil {
+		return true
+	}
+	v := reflect.ValueOf(i)
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+func Nil(t TestingT, skip int, i any) bool {
+	ok := isNil(i)
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s is nil, but got %s", argOr(fstrs[0], i), valueToString(i))
+	}
+	return ok
+}
+
+func NotNil(t TestingT, skip int, i any) bool {
+	ok := !isNil(i)
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s is not nil", argOr(fstrs[0], i))
+	}
+	return ok
+}
+
+func isZero(i any) bool {
+	return i == nil || reflect.DeepEqual(i, reflect.Zero(reflect.TypeOf(i)).Interface())
+}
+
+func Zero(t TestingT, skip int, i any) bool {
+	zero := isZero(i)
+	if !zero {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s is zero, but got %s", argOr(fstrs[0], i), valueToString(i))
+	}
+	return zero
+}
+
+func NotZero(t TestingT, skip int, i any) bool {
+	zero := isZero(i)
+	if zero {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s is not zero", argOr(fstrs[0], i))
+	}
+	return !zero
+}
+
+func Less[T constraints.Ordered](t TestingT, skip int, expected, actual T) bool {
+	ok := actual < expected
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 2)
+		t.Errorf("Expect %s is less than %s, but got %s",
+			argOr(fstrs[0], actual), valueToString(expected), valueToString(actual))
+	}
+	return ok
+}
+
+func Greater[T constraints.Ordered](t TestingT, skip int, expected, actual T) bool {
+	ok := actual > expected
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 2)
+		t.Errorf("Expect %s is greater than %s, but got %s",
+			argOr(fstrs[0], actual), valueToString(expected), valueToString(actual))
+	}
+	return ok
+}
+
+func Len(t TestingT, skip int, obj any, n int) bool {
+	v := reflect.ValueOf(obj)
+	switch v.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+	default:
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s has a length, but it is %s", argOr(fstrs[0], obj), valueToString(obj))
+		return false
+	}
+	ok := v.Len() == n
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s has %d elements, but got %d: %s", argOr(fstrs[0], obj), n, v.Len(), valueToString(obj))
+	}
+	return ok
+}
+
+// includes reports whether container includes elem, found is false if container is not a string, array, slice or map.
+func includes(container, elem any) (ok, found bool) {
+	v := reflect.ValueOf(container)
+	switch v.Kind() {
+	case reflect.String:
+		s, isStr := elem.(string)
+		return isStr && strings.Contains(v.String(), s), true
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			if isEqual(k.Interface(), elem, DefaultFloatDelta) {
+				return true, true
+			}
+		}
+		return false, true
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if isEqual(v.Index(i).Interface(), elem, DefaultFloatDelta) {
+				return true, true
+			}
+		}
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+func Contains(t TestingT, skip int, container, elem any) bool {
+	ok, found := includes(container, elem)
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		if !found {
+			t.Errorf("Expect %s is a string, array, slice or map, but it is %s", argOr(fstrs[0], container), valueToString(container))
+		} else {
+			t.Errorf("Expect %s contains %s, but got %s", argOr(fstrs[0], container), valueToString(elem), valueToString(container))
+		}
+	}
+	return ok
+}
+
+func NotContains(t TestingT, skip int, container, elem any) bool {
+	ok, found := includes(container, elem)
+	if ok || !found {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		if !found {
+			t.Errorf("Expect %s is a string, array, slice or map, but it is %s", argOr(fstrs[0], container), valueToString(container))
+		} else {
+			t.Errorf("Expect %s does not contain %s, but got %s", argOr(fstrs[0], container), valueToString(elem), valueToString(container))
+		}
+	}
+	return !ok && found
+}
+
+// diffElements returns the elements of a not matched in b and those of b not matched in a.
+func diffElements[T any](a, b []T) (extraA, extraB []T) {
+	used := make([]bool, len(b))
+	for _, x := range a {
+		found := false
+		for j, y := range b {
+			if !used[j] && isEqual(x, y, DefaultFloatDelta) {
+				used[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			extraA = append(extraA, x)
+		}
+	}
+	for j, y := range b {
+		if !used[j] {
+			extraB = append(extraB, y)
+		}
+	}
+	return extraA, extraB
+}
+
+func ElementsMatch[T any](t TestingT, skip int, expected, actual []T) bool {
+	extraE, extraA := diffElements(expected, actual)
+	ok := len(extraE) == 0 && len(extraA) == 0
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 2)
+		t.Errorf(`
+		Expected: %s
+
+		has the same elements as: %s
+
+		but got: %s
+		missing: %s
+		extra: %s`,
+			argOr(fstrs[0], actual), valueToString(expected), valueToString(actual),
+			valueToString(extraE), valueToString(extraA))
+	}
+	return ok
+}
+
+func NoError(t TestingT, skip int, err error) bool {
+	if err != nil {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s is nil error, but got %q", argOr(fstrs[0], err), err.Error())
+	}
+	return err == nil
+}
+
+func Error(t TestingT, skip int, err error) bool {
+	if err == nil {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s is an error, but got nil", argOr(fstrs[0], err))
+	}
+	return err != nil
+}
+
+func ErrorIs(t TestingT, skip int, err, target error) bool {
+	ok := errors.Is(err, target)
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1, 2)
+		t.Errorf("Expect %s is %s, but got %s", argOr(fstrs[0], err), argOr(fstrs[1], target), valueToString(err))
+	}
+	return ok
+}
+
+func ErrorAs(t TestingT, skip int, err error, target any) bool {
+	ok := errors.As(err, target)
+	if !ok {
+		t.Helper()
+
+		fstrs := pickArgs(skip, 1)
+		t.Errorf("Expect %s is assignable to %T, but got %s", argOr(fstrs[0], err), target, valueToString(err))
+	}
+	return ok
+}
+
+func Eventually(t TestingT, skip int, cond func() bool, waitFor, tick time.Duration) bool {
+	deadline := time.Now().Add(waitFor)
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	for {
+		if cond() {
+			return true
+		}
+		if !time.Now().Before(deadline) {
+			break
+		}
+		<-ticker.C
+	}
+	t.Helper()
+
+	fstrs := pickArgs(skip, 1)
+	t.Errorf("Expect %s is true within %v, but it is not", nameOr(fstrs[0], "cond"), waitFor)
+	return false
+}